@@ -0,0 +1,883 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package db2saasfake provides a stateful, in-memory implementation of the Db2 SaaS API
+// that can be used to exercise db2saasv1 clients without network access.
+//
+// State is kept per deployment. Operations that take an "x-deployment-id" header use the
+// CRN as-is; operations that take an "x-db-profile" header (or an encoded deployment id in
+// the path) are decoded to the same CRN, so a value written through one operation is visible
+// through every other operation on the same deployment.
+package db2saasfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Route identifies an operation served by the fake server.
+// The value is the method and path pattern of the operation.
+type Route string
+
+// Routes served by the fake server.
+const (
+	RouteGetConnectionInfo   Route = "GET /connectioninfo/{deployment_id}"
+	RoutePostAllowlist       Route = "POST /dbsettings/whitelistips"
+	RouteGetAllowlist        Route = "GET /dbsettings/whitelistips"
+	RoutePostUser            Route = "POST /users"
+	RouteGetUsers            Route = "GET /users"
+	RoutePutUser             Route = "PUT /users/{id}"
+	RouteDeleteUser          Route = "DELETE /users/{id}"
+	RouteGetUserByID         Route = "GET /users/{id}"
+	RoutePutAutoscale        Route = "PUT /manage/scaling/auto"
+	RouteGetAutoscale        Route = "GET /manage/scaling/auto"
+	RoutePostDbConfiguration Route = "POST /manage/deployments/custom_setting"
+	RouteGetTuneableParam    Route = "GET /manage/tuneable_param"
+	RouteGetBackups          Route = "GET /manage/backups"
+	RoutePostBackup          Route = "POST /manage/backups/backup"
+	RouteGetRestores         Route = "GET /manage/backups/restore"
+	RoutePostRestore         Route = "POST /manage/backups/restore"
+)
+
+// Routes lists every route served by the fake server.
+var Routes = []Route{
+	RouteGetConnectionInfo,
+	RoutePostAllowlist,
+	RouteGetAllowlist,
+	RoutePostUser,
+	RouteGetUsers,
+	RoutePutUser,
+	RouteDeleteUser,
+	RouteGetUserByID,
+	RoutePutAutoscale,
+	RouteGetAutoscale,
+	RoutePostDbConfiguration,
+	RouteGetTuneableParam,
+	RouteGetBackups,
+	RoutePostBackup,
+	RouteGetRestores,
+	RoutePostRestore,
+}
+
+// Fault describes an error response to inject on a route.
+type Fault struct {
+	// StatusCode is the HTTP status code of the injected response.
+	StatusCode int
+
+	// Message is the error message of the injected response.
+	Message string
+
+	// Times is the number of requests the fault applies to; zero means until it is cleared.
+	Times int
+}
+
+// PasswordMode selects how the user operations of the fake server return passwords.
+type PasswordMode int
+
+const (
+	// PasswordsEchoed returns passwords as they were set.
+	PasswordsEchoed PasswordMode = iota
+
+	// PasswordsOmitted leaves passwords out of user responses.
+	PasswordsOmitted
+
+	// PasswordsMasked replaces passwords with MaskedPassword in user responses.
+	PasswordsMasked
+)
+
+// PasswordModes are the password modes, in the order they are declared.
+var PasswordModes = []PasswordMode{PasswordsEchoed, PasswordsOmitted, PasswordsMasked}
+
+// String returns the name of the mode, e.g. "echoed".
+func (mode PasswordMode) String() string {
+	switch mode {
+	case PasswordsEchoed:
+		return "echoed"
+	case PasswordsOmitted:
+		return "omitted"
+	case PasswordsMasked:
+		return "masked"
+	}
+	return fmt.Sprintf("PasswordMode(%d)", int(mode))
+}
+
+// MaskedPassword is returned in place of passwords when Server.Passwords is PasswordsMasked.
+const MaskedPassword = "********"
+
+// Server is an in-memory Db2 SaaS API server.
+type Server struct {
+	// URL is the base URL of the server, suitable for Db2saasV1Options.URL.
+	URL string
+
+	// DefaultDeploymentID is the deployment used by requests that carry no deployment header.
+	DefaultDeploymentID string
+
+	// BackupPolls is the number of backup list requests during which a backup created through
+	// PostDb2SaasBackup reports "in_progress" before it becomes "completed".
+	BackupPolls int
+
+	// BackupPageSize is the number of backups per page when a backup list request sets no limit. Zero lists
+	// every backup on one page.
+	BackupPageSize int
+
+	// RestorePolls is the number of restore list requests during which a restore created through
+	// PostDb2SaasRestore reports "in_progress" before it becomes "completed".
+	RestorePolls int
+
+	// Passwords selects how the user operations return passwords; the default echoes them.
+	Passwords PasswordMode
+
+	httpServer  *httptest.Server
+	mutex       sync.Mutex
+	deployments map[string]*deployment
+	faults      map[Route]*Fault
+	calls       map[Route]int
+	taskCounter int
+}
+
+// deployment holds the state of a single Db2 instance.
+type deployment struct {
+	connectionInfo *db2saasv1.SuccessConnectionInfo
+	allowlist      []db2saasv1.IpAddress
+	users          map[string]*db2saasv1.SuccessUserResponse
+	autoscale      *db2saasv1.SuccessAutoScaling
+	settings       map[string]map[string]string
+	backups        []db2saasv1.Backup
+	restores       []db2saasv1.Restore
+	pendingPolls   map[string]int
+}
+
+// NewServer starts a new fake server. Callers should Close it when done.
+func NewServer() *Server {
+	server := &Server{
+		deployments: make(map[string]*deployment),
+		faults:      make(map[Route]*Fault),
+		calls:       make(map[Route]int),
+	}
+
+	handlers := map[Route]func(*deployment, http.ResponseWriter, *http.Request){
+		RouteGetConnectionInfo:   server.getConnectionInfo,
+		RoutePostAllowlist:       server.postAllowlist,
+		RouteGetAllowlist:        server.getAllowlist,
+		RoutePostUser:            server.postUser,
+		RouteGetUsers:            server.getUsers,
+		RoutePutUser:             server.putUser,
+		RouteDeleteUser:          server.deleteUser,
+		RouteGetUserByID:         server.getUserByID,
+		RoutePutAutoscale:        server.putAutoscale,
+		RouteGetAutoscale:        server.getAutoscale,
+		RoutePostDbConfiguration: server.postDbConfiguration,
+		RouteGetTuneableParam:    server.getTuneableParam,
+		RouteGetBackups:          server.getBackups,
+		RoutePostBackup:          server.postBackup,
+		RouteGetRestores:         server.getRestores,
+		RoutePostRestore:         server.postRestore,
+	}
+
+	mux := http.NewServeMux()
+	for route, handler := range handlers {
+		mux.HandleFunc(string(route), server.serve(route, handler))
+	}
+	server.httpServer = httptest.NewServer(mux)
+	server.URL = server.httpServer.URL
+	return server
+}
+
+// Close shuts down the server.
+func (server *Server) Close() {
+	server.httpServer.Close()
+}
+
+// NewService returns a Db2saasV1 client that talks to the server without authentication.
+func (server *Server) NewService() (*db2saasv1.Db2saasV1, error) {
+	return db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+}
+
+// InjectError makes subsequent requests on "route" fail with "fault".
+func (server *Server) InjectError(route Route, fault Fault) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.faults[route] = &fault
+}
+
+// ClearErrors removes all injected faults.
+func (server *Server) ClearErrors() {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.faults = make(map[Route]*Fault)
+}
+
+// Calls returns the number of requests received on "route", including failed ones.
+func (server *Server) Calls(route Route) int {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return server.calls[route]
+}
+
+// SetConnectionInfo sets the connection information returned for a deployment.
+func (server *Server) SetConnectionInfo(crn string, info *db2saasv1.SuccessConnectionInfo) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.deployment(crn).connectionInfo = info
+}
+
+// SetAutoscale sets the autoscale configuration of a deployment.
+func (server *Server) SetAutoscale(crn string, autoscale *db2saasv1.SuccessAutoScaling) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.deployment(crn).autoscale = autoscale
+}
+
+// AddBackup appends a backup to the backups of a deployment.
+func (server *Server) AddBackup(crn string, backup db2saasv1.Backup) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	d := server.deployment(crn)
+	d.backups = append(d.backups, backup)
+}
+
+// SetBackupStatus updates the status of a backup. It returns false if the backup does not exist.
+func (server *Server) SetBackupStatus(crn string, id string, status string) bool {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	d := server.deployment(crn)
+	for i := range d.backups {
+		if *d.backups[i].ID == id {
+			d.backups[i].Status = core.StringPtr(status)
+			delete(d.pendingPolls, id)
+			return true
+		}
+	}
+	return false
+}
+
+// SetRestoreStatus updates the status of a restore. It returns false if the restore does not exist.
+func (server *Server) SetRestoreStatus(crn string, id string, status string) bool {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	d := server.deployment(crn)
+	for i := range d.restores {
+		if *d.restores[i].ID == id {
+			d.restores[i].Status = core.StringPtr(status)
+			delete(d.pendingPolls, id)
+			return true
+		}
+	}
+	return false
+}
+
+// SetSetting sets the current value of a tuneable parameter. "scope" is one of "db", "dbm" or "registry"
+// and "name" is the wire name of the parameter, e.g. "LOCKTIMEOUT".
+func (server *Server) SetSetting(crn string, scope string, name string, value string) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	d := server.deployment(crn)
+	if d.settings[scope] == nil {
+		d.settings[scope] = make(map[string]string)
+	}
+	d.settings[scope][name] = value
+}
+
+// Setting returns the current value of a tuneable parameter.
+func (server *Server) Setting(crn string, scope string, name string) (value string, ok bool) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	value, ok = server.deployment(crn).settings[scope][name]
+	return
+}
+
+// Allowlist returns a copy of the allowlist of a deployment.
+func (server *Server) Allowlist(crn string) []db2saasv1.IpAddress {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return append([]db2saasv1.IpAddress(nil), server.deployment(crn).allowlist...)
+}
+
+// UserIDs returns the sorted ids of the users of a deployment.
+func (server *Server) UserIDs(crn string) []string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return server.deployment(crn).userIDs()
+}
+
+// deployment returns the state of the deployment identified by "crn", creating it if needed.
+// The caller must hold the mutex.
+func (server *Server) deployment(crn string) *deployment {
+	d, ok := server.deployments[crn]
+	if !ok {
+		d = &deployment{
+			users:        make(map[string]*db2saasv1.SuccessUserResponse),
+			settings:     make(map[string]map[string]string),
+			pendingPolls: make(map[string]int),
+			autoscale: &db2saasv1.SuccessAutoScaling{
+				AutoScalingAllowPlanLimit:    core.BoolPtr(false),
+				AutoScalingEnabled:           core.BoolPtr(false),
+				AutoScalingMaxStorage:        core.Int64Ptr(0),
+				AutoScalingOverTimePeriod:    core.Int64Ptr(0),
+				AutoScalingPauseLimit:        core.Int64Ptr(0),
+				AutoScalingThreshold:         core.Int64Ptr(0),
+				StorageUnit:                  core.StringPtr("GB"),
+				StorageUtilizationPercentage: core.Int64Ptr(0),
+				SupportAutoScaling:           core.BoolPtr(true),
+			},
+		}
+		server.deployments[crn] = d
+	}
+	return d
+}
+
+// serve wraps a route handler with call counting, fault injection and deployment lookup.
+func (server *Server) serve(route Route, handler func(*deployment, http.ResponseWriter, *http.Request)) http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+
+		server.calls[route]++
+		if fault, ok := server.faults[route]; ok {
+			if fault.Times > 0 {
+				fault.Times--
+				if fault.Times == 0 {
+					delete(server.faults, route)
+				}
+			}
+			writeError(res, fault.StatusCode, fault.Message)
+			return
+		}
+
+		crn, err := deploymentID(route, req)
+		if err != nil {
+			writeError(res, http.StatusBadRequest, err.Error())
+			return
+		}
+		if crn == "" {
+			crn = server.DefaultDeploymentID
+		}
+		handler(server.deployment(crn), res, req)
+	}
+}
+
+// deploymentID extracts the CRN of the deployment addressed by a request.
+func deploymentID(route Route, req *http.Request) (string, error) {
+	switch route {
+	case RouteGetConnectionInfo:
+		return url.QueryUnescape(req.PathValue("deployment_id"))
+	case RoutePutAutoscale, RouteGetAutoscale, RoutePostDbConfiguration, RouteGetBackups, RoutePostBackup,
+		RouteGetRestores, RoutePostRestore:
+		profile := req.Header.Get("x-db-profile")
+		if profile == "" {
+			return "", fmt.Errorf("missing x-db-profile header")
+		}
+		return url.QueryUnescape(profile)
+	case RouteGetTuneableParam:
+		return url.QueryUnescape(req.Header.Get("x-db-profile"))
+	default:
+		crn := req.Header.Get("x-deployment-id")
+		if crn == "" {
+			return "", fmt.Errorf("missing x-deployment-id header")
+		}
+		return crn, nil
+	}
+}
+
+func (server *Server) getConnectionInfo(d *deployment, res http.ResponseWriter, req *http.Request) {
+	if d.connectionInfo == nil {
+		writeError(res, http.StatusNotFound, "connection information not found")
+		return
+	}
+	writeJSON(res, http.StatusOK, d.connectionInfo)
+}
+
+func (server *Server) postAllowlist(d *deployment, res http.ResponseWriter, req *http.Request) {
+	var body struct {
+		IpAddresses []db2saasv1.IpAddress `json:"ip_addresses"`
+	}
+	if !readJSON(res, req, &body) {
+		return
+	}
+	for i, ip := range body.IpAddresses {
+		if ip.Address == nil || ip.Description == nil {
+			writeError(res, http.StatusBadRequest, fmt.Sprintf("ip_addresses[%d]: address and description are required", i))
+			return
+		}
+	}
+	d.allowlist = body.IpAddresses
+	writeJSON(res, http.StatusOK, &db2saasv1.SuccessPostAllowedlistIPs{
+		Status: core.StringPtr("success"),
+	})
+}
+
+func (server *Server) getAllowlist(d *deployment, res http.ResponseWriter, req *http.Request) {
+	writeJSON(res, http.StatusOK, &db2saasv1.SuccessGetAllowlistIPs{
+		IpAddresses: append([]db2saasv1.IpAddress{}, d.allowlist...),
+	})
+}
+
+// userBody is the request body of the create and update user operations.
+type userBody struct {
+	ID             *string                                      `json:"id"`
+	Iam            *bool                                        `json:"iam"`
+	Ibmid          *string                                      `json:"ibmid"`
+	Name           *string                                      `json:"name"`
+	Password       *string                                      `json:"password"`
+	Role           *string                                      `json:"role"`
+	Email          *string                                      `json:"email"`
+	Locked         *string                                      `json:"locked"`
+	Authentication *db2saasv1.SuccessUserResponseAuthentication `json:"authentication"`
+}
+
+// validate checks that every field of the body is set and that enumerated fields have valid values.
+func (body *userBody) validate() error {
+	if body.ID == nil || body.Iam == nil || body.Ibmid == nil || body.Name == nil || body.Password == nil ||
+		body.Role == nil || body.Email == nil || body.Locked == nil || body.Authentication == nil {
+		return fmt.Errorf("id, iam, ibmid, name, password, role, email, locked and authentication are required")
+	}
+	if *body.Role != db2saasv1.PostDb2SaasUserOptions_Role_Bluadmin && *body.Role != db2saasv1.PostDb2SaasUserOptions_Role_Bluuser {
+		return fmt.Errorf("invalid role '%s'", *body.Role)
+	}
+	if *body.Locked != db2saasv1.PostDb2SaasUserOptions_Locked_Yes && *body.Locked != db2saasv1.PostDb2SaasUserOptions_Locked_No {
+		return fmt.Errorf("invalid locked value '%s'", *body.Locked)
+	}
+	return nil
+}
+
+// apply copies the body into a user record.
+func (body *userBody) apply(user *db2saasv1.SuccessUserResponse) {
+	user.ID = body.ID
+	user.Iam = body.Iam
+	user.Ibmid = body.Ibmid
+	user.FormatedIbmid = body.Ibmid
+	user.Name = body.Name
+	user.Password = body.Password
+	user.Role = body.Role
+	user.Email = body.Email
+	user.Locked = body.Locked
+	user.Authentication = body.Authentication
+}
+
+func (server *Server) postUser(d *deployment, res http.ResponseWriter, req *http.Request) {
+	var body userBody
+	if !readJSON(res, req, &body) {
+		return
+	}
+	if err := body.validate(); err != nil {
+		writeError(res, http.StatusBadRequest, err.Error())
+		return
+	}
+	if _, exists := d.users[*body.ID]; exists {
+		writeError(res, http.StatusConflict, fmt.Sprintf("user '%s' already exists", *body.ID))
+		return
+	}
+
+	user := &db2saasv1.SuccessUserResponse{
+		DvRole:           core.StringPtr(""),
+		Metadata:         map[string]interface{}{},
+		Iamid:            core.StringPtr(""),
+		PermittedActions: []string{},
+		AllClean:         core.BoolPtr(true),
+		InitErrorMsg:     core.StringPtr(""),
+	}
+	body.apply(user)
+	d.users[*user.ID] = user
+	writeJSON(res, http.StatusOK, server.userResponse(user))
+}
+
+func (server *Server) getUsers(d *deployment, res http.ResponseWriter, req *http.Request) {
+	resources := []*db2saasv1.SuccessUserResponse{}
+	for _, id := range d.userIDs() {
+		resources = append(resources, server.userResponse(d.users[id]))
+	}
+	writeJSON(res, http.StatusOK, map[string]interface{}{
+		"count":     len(resources),
+		"resources": resources,
+	})
+}
+
+func (server *Server) putUser(d *deployment, res http.ResponseWriter, req *http.Request) {
+	id := req.PathValue("id")
+	user, ok := d.users[id]
+	if !ok {
+		writeError(res, http.StatusNotFound, fmt.Sprintf("user '%s' not found", id))
+		return
+	}
+	var body userBody
+	if !readJSON(res, req, &body) {
+		return
+	}
+	if err := body.validate(); err != nil {
+		writeError(res, http.StatusBadRequest, err.Error())
+		return
+	}
+	if *body.ID != id {
+		if _, exists := d.users[*body.ID]; exists {
+			writeError(res, http.StatusConflict, fmt.Sprintf("user '%s' already exists", *body.ID))
+			return
+		}
+		delete(d.users, id)
+	}
+	body.apply(user)
+	d.users[*user.ID] = user
+	writeJSON(res, http.StatusOK, server.userResponse(user))
+}
+
+func (server *Server) deleteUser(d *deployment, res http.ResponseWriter, req *http.Request) {
+	id := req.PathValue("id")
+	if _, ok := d.users[id]; !ok {
+		writeError(res, http.StatusNotFound, fmt.Sprintf("user '%s' not found", id))
+		return
+	}
+	delete(d.users, id)
+	res.WriteHeader(http.StatusNoContent)
+}
+
+func (server *Server) getUserByID(d *deployment, res http.ResponseWriter, req *http.Request) {
+	id := req.PathValue("id")
+	user, ok := d.users[id]
+	if !ok {
+		writeError(res, http.StatusNotFound, fmt.Sprintf("user '%s' not found", id))
+		return
+	}
+	writeJSON(res, http.StatusOK, server.userResponse(user))
+}
+
+// userResponse returns "user" as the user operations return it, with its password set as Passwords selects.
+func (server *Server) userResponse(user *db2saasv1.SuccessUserResponse) *db2saasv1.SuccessUserResponse {
+	response := *user
+	switch server.Passwords {
+	case PasswordsOmitted:
+		response.Password = nil
+	case PasswordsMasked:
+		response.Password = core.StringPtr(MaskedPassword)
+	}
+	return &response
+}
+
+func (server *Server) putAutoscale(d *deployment, res http.ResponseWriter, req *http.Request) {
+	var body struct {
+		AutoScalingEnabled        *string  `json:"auto_scaling_enabled"`
+		AutoScalingThreshold      *int64   `json:"auto_scaling_threshold"`
+		AutoScalingOverTimePeriod *float64 `json:"auto_scaling_over_time_period"`
+		AutoScalingPauseLimit     *int64   `json:"auto_scaling_pause_limit"`
+		AutoScalingAllowPlanLimit *string  `json:"auto_scaling_allow_plan_limit"`
+	}
+	if !readJSON(res, req, &body) {
+		return
+	}
+
+	updated := *d.autoscale
+	if body.AutoScalingEnabled != nil {
+		switch *body.AutoScalingEnabled {
+		case db2saasv1.PutDb2SaasAutoscaleOptions_AutoScalingEnabled_True:
+			updated.AutoScalingEnabled = core.BoolPtr(true)
+		case db2saasv1.PutDb2SaasAutoscaleOptions_AutoScalingEnabled_False:
+			updated.AutoScalingEnabled = core.BoolPtr(false)
+		default:
+			writeError(res, http.StatusBadRequest, fmt.Sprintf("invalid auto_scaling_enabled value '%s'", *body.AutoScalingEnabled))
+			return
+		}
+	}
+	if body.AutoScalingAllowPlanLimit != nil {
+		switch *body.AutoScalingAllowPlanLimit {
+		case db2saasv1.PutDb2SaasAutoscaleOptions_AutoScalingAllowPlanLimit_Yes:
+			updated.AutoScalingAllowPlanLimit = core.BoolPtr(true)
+		case db2saasv1.PutDb2SaasAutoscaleOptions_AutoScalingAllowPlanLimit_No:
+			updated.AutoScalingAllowPlanLimit = core.BoolPtr(false)
+		default:
+			writeError(res, http.StatusBadRequest, fmt.Sprintf("invalid auto_scaling_allow_plan_limit value '%s'", *body.AutoScalingAllowPlanLimit))
+			return
+		}
+	}
+	if body.AutoScalingThreshold != nil {
+		updated.AutoScalingThreshold = body.AutoScalingThreshold
+	}
+	if body.AutoScalingOverTimePeriod != nil {
+		updated.AutoScalingOverTimePeriod = core.Int64Ptr(int64(*body.AutoScalingOverTimePeriod))
+	}
+	if body.AutoScalingPauseLimit != nil {
+		updated.AutoScalingPauseLimit = body.AutoScalingPauseLimit
+	}
+	d.autoscale = &updated
+	writeJSON(res, http.StatusOK, &db2saasv1.SuccessUpdateAutoScale{
+		Message: core.StringPtr("auto scaling configuration updated"),
+	})
+}
+
+func (server *Server) getAutoscale(d *deployment, res http.ResponseWriter, req *http.Request) {
+	writeJSON(res, http.StatusOK, d.autoscale)
+}
+
+func (server *Server) postDbConfiguration(d *deployment, res http.ResponseWriter, req *http.Request) {
+	var body map[string]map[string]string
+	if !readJSON(res, req, &body) {
+		return
+	}
+	for scope := range body {
+		if scope != "db" && scope != "dbm" && scope != "registry" {
+			writeError(res, http.StatusBadRequest, fmt.Sprintf("unknown configuration scope '%s'", scope))
+			return
+		}
+	}
+	for scope, values := range body {
+		if d.settings[scope] == nil {
+			d.settings[scope] = make(map[string]string)
+		}
+		for name, value := range values {
+			d.settings[scope][name] = value
+		}
+	}
+	crn, _ := deploymentID(RoutePostDbConfiguration, req)
+	writeJSON(res, http.StatusOK, &db2saasv1.SuccessPostCustomSettings{
+		Description: core.StringPtr("custom settings updated"),
+		ID:          core.StringPtr(crn),
+		Status:      core.StringPtr("success"),
+	})
+}
+
+func (server *Server) getTuneableParam(d *deployment, res http.ResponseWriter, req *http.Request) {
+	tuneableParam := map[string]map[string]string{}
+	for _, scope := range []string{"db", "dbm", "registry"} {
+		tuneableParam[scope] = map[string]string{}
+		for name, value := range d.settings[scope] {
+			tuneableParam[scope][name] = value
+		}
+	}
+	writeJSON(res, http.StatusOK, map[string]interface{}{
+		"tuneable_param": tuneableParam,
+	})
+}
+
+func (server *Server) getBackups(d *deployment, res http.ResponseWriter, req *http.Request) {
+	for i := range d.backups {
+		d.advance(*d.backups[i].ID, &d.backups[i].Status)
+	}
+
+	query := req.URL.Query()
+	filter := &db2saasv1.BackupFilter{}
+	if value := query.Get("type"); value != "" {
+		filter.Types = []string{value}
+	}
+	if value := query.Get("status"); value != "" {
+		filter.Statuses = []string{value}
+	}
+	for name, field := range map[string]*time.Time{"created_after": &filter.CreatedAfter, "created_before": &filter.CreatedBefore} {
+		if value := query.Get(name); value != "" {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				writeError(res, http.StatusBadRequest, fmt.Sprintf("invalid %s: %s", name, err.Error()))
+				return
+			}
+			*field = t
+		}
+	}
+	backups := db2saasv1.FilterBackups(d.backups, filter)
+
+	switch query.Get("sort") {
+	case "":
+	case db2saasv1.GetDb2SaasBackupOptions_Sort_CreatedAt:
+		db2saasv1.SortBackupsByCreatedAt(backups, false)
+	case db2saasv1.GetDb2SaasBackupOptions_Sort_CreatedAtDesc:
+		db2saasv1.SortBackupsByCreatedAt(backups, true)
+	default:
+		writeError(res, http.StatusBadRequest, fmt.Sprintf("invalid sort '%s'", query.Get("sort")))
+		return
+	}
+
+	result := &db2saasv1.SuccessGetBackups{
+		TotalCount: core.Int64Ptr(int64(len(backups))),
+	}
+	start, limit := 0, len(backups)
+	if server.BackupPageSize > 0 {
+		limit = server.BackupPageSize
+	}
+	if value := query.Get("start"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			writeError(res, http.StatusBadRequest, fmt.Sprintf("invalid start '%s'", value))
+			return
+		}
+		start = min(n, len(backups))
+	}
+	if value := query.Get("limit"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 {
+			writeError(res, http.StatusBadRequest, fmt.Sprintf("invalid limit '%s'", value))
+			return
+		}
+		limit = n
+		result.Limit = core.Int64Ptr(int64(n))
+	}
+	end := min(start+limit, len(backups))
+	result.Backups = backups[start:end]
+	if end < len(backups) {
+		next := req.URL.Query()
+		next.Set("start", strconv.Itoa(end))
+		result.Next = &db2saasv1.PaginationLink{
+			Href: core.StringPtr(req.URL.Path + "?" + next.Encode()),
+		}
+	}
+	writeJSON(res, http.StatusOK, result)
+}
+
+func (server *Server) postBackup(d *deployment, res http.ResponseWriter, req *http.Request) {
+	crn, _ := deploymentID(RoutePostBackup, req)
+	server.taskCounter++
+	taskID := fmt.Sprintf("%s:task:%d", crn, server.taskCounter)
+	status := d.track(taskID, server.BackupPolls)
+	d.backups = append(d.backups, db2saasv1.Backup{
+		ID:        core.StringPtr(taskID),
+		Type:      core.StringPtr("on_demand"),
+		Status:    core.StringPtr(status),
+		CreatedAt: core.StringPtr(time.Now().UTC().Format(time.RFC3339)),
+		Size:      core.Int64Ptr(0),
+		Duration:  core.Int64Ptr(0),
+	})
+	writeJSON(res, http.StatusOK, &db2saasv1.SuccessCreateBackup{
+		Task: &db2saasv1.SuccessCreateBackupTask{
+			ID: core.StringPtr(taskID),
+		},
+	})
+}
+
+func (server *Server) getRestores(d *deployment, res http.ResponseWriter, req *http.Request) {
+	for i := range d.restores {
+		d.advance(*d.restores[i].ID, &d.restores[i].Status)
+	}
+	writeJSON(res, http.StatusOK, &db2saasv1.SuccessGetRestores{
+		Restores: append([]db2saasv1.Restore{}, d.restores...),
+	})
+}
+
+func (server *Server) postRestore(d *deployment, res http.ResponseWriter, req *http.Request) {
+	var body struct {
+		BackupID   *string `json:"backup_id"`
+		TargetTime *string `json:"target_time"`
+	}
+	if !readJSON(res, req, &body) {
+		return
+	}
+	if body.BackupID == nil && body.TargetTime == nil {
+		writeError(res, http.StatusBadRequest, "backup_id or target_time is required")
+		return
+	}
+	if body.TargetTime != nil {
+		if _, err := time.Parse(time.RFC3339, *body.TargetTime); err != nil {
+			writeError(res, http.StatusBadRequest, fmt.Sprintf("invalid target_time: %s", err.Error()))
+			return
+		}
+	}
+	if body.BackupID != nil {
+		found := false
+		for _, backup := range d.backups {
+			found = found || *backup.ID == *body.BackupID
+		}
+		if !found {
+			writeError(res, http.StatusNotFound, fmt.Sprintf("backup '%s' not found", *body.BackupID))
+			return
+		}
+	}
+
+	crn, _ := deploymentID(RoutePostRestore, req)
+	server.taskCounter++
+	taskID := fmt.Sprintf("%s:task:%d", crn, server.taskCounter)
+	status := d.track(taskID, server.RestorePolls)
+	d.restores = append(d.restores, db2saasv1.Restore{
+		ID:         core.StringPtr(taskID),
+		BackupID:   body.BackupID,
+		TargetTime: body.TargetTime,
+		Status:     core.StringPtr(status),
+		CreatedAt:  core.StringPtr(time.Now().UTC().Format(time.RFC3339)),
+	})
+	writeJSON(res, http.StatusOK, &db2saasv1.SuccessCreateRestore{
+		Task: &db2saasv1.SuccessCreateRestoreTask{
+			ID: core.StringPtr(taskID),
+		},
+	})
+}
+
+// track returns the initial status of a new task that completes after "polls" list requests.
+func (d *deployment) track(taskID string, polls int) string {
+	if polls <= 0 {
+		return "completed"
+	}
+	d.pendingPolls[taskID] = polls
+	return "in_progress"
+}
+
+// advance counts a list request against a tracked task and marks it completed once it is due.
+func (d *deployment) advance(taskID string, status **string) {
+	polls, ok := d.pendingPolls[taskID]
+	if !ok {
+		return
+	}
+	if polls <= 0 {
+		*status = core.StringPtr("completed")
+		delete(d.pendingPolls, taskID)
+		return
+	}
+	d.pendingPolls[taskID] = polls - 1
+}
+
+// userIDs returns the sorted ids of the users of the deployment.
+func (d *deployment) userIDs() []string {
+	ids := make([]string, 0, len(d.users))
+	for id := range d.users {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// readJSON decodes the request body into "v", writing a 400 response if it is not valid JSON.
+func readJSON(res http.ResponseWriter, req *http.Request, v interface{}) bool {
+	err := json.NewDecoder(req.Body).Decode(v)
+	if err != nil {
+		writeError(res, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// writeJSON writes "v" as a JSON response.
+func writeJSON(res http.ResponseWriter, statusCode int, v interface{}) {
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(statusCode)
+	_ = json.NewEncoder(res).Encode(v)
+}
+
+// writeError writes an error response in the format used by IBM Cloud APIs.
+func writeError(res http.ResponseWriter, statusCode int, message string) {
+	writeJSON(res, statusCode, map[string]interface{}{
+		"errors": []map[string]string{
+			{
+				"code":    http.StatusText(statusCode),
+				"message": message,
+			},
+		},
+		"status_code": statusCode,
+	})
+}
@@ -0,0 +1,335 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasfake_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/cloud-db2-go-sdk/db2saasfake"
+	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCRN = "crn:v1:staging:public:dashdb-for-transactions:us-south:a/e7e3e87b512f474381c0684a5ecbba03:39269573-e43f-43e8-8b93-09f44c2ff875::"
+
+var testProfile = url.QueryEscape(testCRN)
+
+func newTestServer(t *testing.T) (*db2saasfake.Server, *db2saasv1.Db2saasV1) {
+	server := db2saasfake.NewServer()
+	t.Cleanup(server.Close)
+	service, err := server.NewService()
+	require.Nil(t, err)
+	return server, service
+}
+
+func TestUsers(t *testing.T) {
+	server, service := newTestServer(t)
+
+	authentication, err := service.NewCreateUserAuthentication("internal", "Default")
+	require.Nil(t, err)
+	createOptions := service.NewPostDb2SaasUserOptions(testCRN, "test-user", false, "test-ibm-id", "Test User",
+		"dEkMc43@gfAPl!867^dSbu", "bluuser", "test@host.org", "no", authentication)
+
+	created, _, err := service.PostDb2SaasUser(createOptions)
+	require.Nil(t, err)
+	assert.Equal(t, "test-user", *created.ID)
+
+	_, response, err := service.PostDb2SaasUser(createOptions)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusConflict, response.StatusCode)
+
+	user, _, err := service.GetbyidDb2SaasUser(service.NewGetbyidDb2SaasUserOptions(testCRN, "test-user"))
+	require.Nil(t, err)
+	assert.Equal(t, "test@host.org", *user.Email)
+
+	users, _, err := service.GetDb2SaasUser(service.NewGetDb2SaasUserOptions(testCRN))
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), *users.Count)
+	assert.Equal(t, "test-user", *users.Resources[0].ID)
+
+	updateAuthentication, err := service.NewUpdateUserAuthentication("internal", "Default")
+	require.Nil(t, err)
+	_, _, err = service.PutDb2SaasUser(service.NewPutDb2SaasUserOptions(testCRN, "test-user", "test-user", false,
+		"test-ibm-id", "Test User", "dEkMc43@gfAPl!867^dSbu", "bluadmin", "new@host.org", "yes", updateAuthentication))
+	require.Nil(t, err)
+
+	user, _, err = service.GetbyidDb2SaasUser(service.NewGetbyidDb2SaasUserOptions(testCRN, "test-user"))
+	require.Nil(t, err)
+	assert.Equal(t, "new@host.org", *user.Email)
+	assert.Equal(t, "bluadmin", *user.Role)
+	assert.Equal(t, "yes", *user.Locked)
+
+	_, err = service.DeleteDb2SaasUser(service.NewDeleteDb2SaasUserOptions(testCRN, "test-user"))
+	require.Nil(t, err)
+	assert.Empty(t, server.UserIDs(testCRN))
+
+	_, response, err = service.GetbyidDb2SaasUser(service.NewGetbyidDb2SaasUserOptions(testCRN, "test-user"))
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
+func TestPasswords(t *testing.T) {
+	server, service := newTestServer(t)
+	service.SetRedactPasswords(false)
+
+	authentication, err := service.NewCreateUserAuthentication("internal", "Default")
+	require.Nil(t, err)
+	_, _, err = service.PostDb2SaasUser(service.NewPostDb2SaasUserOptions(testCRN, "test-user", false, "test-ibm-id",
+		"Test User", "dEkMc43@gfAPl!867^dSbu", "bluuser", "test@host.org", "no", authentication))
+	require.Nil(t, err)
+
+	for mode, expected := range map[db2saasfake.PasswordMode]*string{
+		db2saasfake.PasswordsEchoed:  core.StringPtr("dEkMc43@gfAPl!867^dSbu"),
+		db2saasfake.PasswordsOmitted: nil,
+		db2saasfake.PasswordsMasked:  core.StringPtr(db2saasfake.MaskedPassword),
+	} {
+		server.Passwords = mode
+		user, _, err := service.GetbyidDb2SaasUser(service.NewGetbyidDb2SaasUserOptions(testCRN, "test-user"))
+		require.Nil(t, err)
+		assert.Equal(t, expected, user.Password, mode)
+
+		users, _, err := service.GetDb2SaasUser(service.NewGetDb2SaasUserOptions(testCRN))
+		require.Nil(t, err)
+		assert.Equal(t, expected, users.Resources[0].Password, mode)
+	}
+	assert.Equal(t, []string{"echoed", "omitted", "masked"}, []string{
+		db2saasfake.PasswordModes[0].String(), db2saasfake.PasswordModes[1].String(), db2saasfake.PasswordModes[2].String(),
+	})
+}
+
+func TestAllowlist(t *testing.T) {
+	server, service := newTestServer(t)
+
+	ipAddress, err := service.NewIpAddress("10.0.0.0/24", "office")
+	require.Nil(t, err)
+	_, _, err = service.PostDb2SaasAllowlist(service.NewPostDb2SaasAllowlistOptions(testCRN, []db2saasv1.IpAddress{*ipAddress}))
+	require.Nil(t, err)
+
+	allowlist, _, err := service.GetDb2SaasAllowlist(service.NewGetDb2SaasAllowlistOptions(testCRN))
+	require.Nil(t, err)
+	assert.Equal(t, []db2saasv1.IpAddress{*ipAddress}, allowlist.IpAddresses)
+	assert.Equal(t, allowlist.IpAddresses, server.Allowlist(testCRN))
+
+	otherList, _, err := service.GetDb2SaasAllowlist(service.NewGetDb2SaasAllowlistOptions("crn:other"))
+	require.Nil(t, err)
+	assert.Empty(t, otherList.IpAddresses)
+}
+
+func TestAutoscale(t *testing.T) {
+	server, service := newTestServer(t)
+
+	options := service.NewPutDb2SaasAutoscaleOptions(testProfile)
+	options.SetAutoScalingEnabled("true")
+	options.SetAutoScalingThreshold(90)
+	_, _, err := service.PutDb2SaasAutoscale(options)
+	require.Nil(t, err)
+
+	autoscale, _, err := service.GetDb2SaasAutoscale(service.NewGetDb2SaasAutoscaleOptions(testProfile))
+	require.Nil(t, err)
+	assert.True(t, *autoscale.AutoScalingEnabled)
+	assert.Equal(t, int64(90), *autoscale.AutoScalingThreshold)
+
+	// The SDK rejects "True" before sending it, so send the request directly.
+	request, err := http.NewRequest(http.MethodPut, server.URL+"/manage/scaling/auto", strings.NewReader(`{"auto_scaling_enabled": "True"}`))
+	require.Nil(t, err)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("x-db-profile", testProfile)
+	response, err := http.DefaultClient.Do(request)
+	require.Nil(t, err)
+	response.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+}
+
+func TestConfiguration(t *testing.T) {
+	server, service := newTestServer(t)
+	server.DefaultDeploymentID = testCRN
+
+	options := service.NewPostDb2SaasDbConfigurationOptions(testProfile)
+	options.SetDb(&db2saasv1.CreateCustomSettingsDb{LOCKTIMEOUT: core.StringPtr("30")})
+	options.SetRegistry(&db2saasv1.CreateCustomSettingsRegistry{DB2WORKLOAD: core.StringPtr("ANALYTICS")})
+	_, _, err := service.PostDb2SaasDbConfiguration(options)
+	require.Nil(t, err)
+
+	value, ok := server.Setting(testCRN, "db", "LOCKTIMEOUT")
+	assert.True(t, ok)
+	assert.Equal(t, "30", value)
+
+	params, _, err := service.GetDb2SaasTuneableParam(service.NewGetDb2SaasTuneableParamOptions())
+	require.Nil(t, err)
+	assert.Equal(t, "30", *params.TuneableParam.Db.LOCKTIMEOUT)
+	assert.Equal(t, "ANALYTICS", *params.TuneableParam.Registry.DB2WORKLOAD)
+}
+
+func TestBackups(t *testing.T) {
+	server, service := newTestServer(t)
+
+	created, _, err := service.PostDb2SaasBackup(service.NewPostDb2SaasBackupOptions(testProfile))
+	require.Nil(t, err)
+
+	backups, _, err := service.GetDb2SaasBackup(service.NewGetDb2SaasBackupOptions(testProfile))
+	require.Nil(t, err)
+	require.Len(t, backups.Backups, 1)
+	assert.Equal(t, *created.Task.ID, *backups.Backups[0].ID)
+
+	assert.True(t, server.SetBackupStatus(testCRN, *created.Task.ID, "failed"))
+	backups, _, err = service.GetDb2SaasBackup(service.NewGetDb2SaasBackupOptions(testProfile))
+	require.Nil(t, err)
+	assert.Equal(t, "failed", *backups.Backups[0].Status)
+}
+
+func TestConnectionInfo(t *testing.T) {
+	server, service := newTestServer(t)
+
+	_, response, err := service.GetDb2SaasConnectionInfo(service.NewGetDb2SaasConnectionInfoOptions(testProfile, testCRN))
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+	server.SetConnectionInfo(testCRN, &db2saasv1.SuccessConnectionInfo{
+		Public: &db2saasv1.SuccessConnectionInfoPublic{
+			Hostname:     core.StringPtr("public.example.com"),
+			DatabaseName: core.StringPtr("bluedb"),
+		},
+	})
+	info, _, err := service.GetDb2SaasConnectionInfo(service.NewGetDb2SaasConnectionInfoOptions(testProfile, testCRN))
+	require.Nil(t, err)
+	assert.Equal(t, "public.example.com", *info.Public.Hostname)
+}
+
+func TestInjectError(t *testing.T) {
+	server, service := newTestServer(t)
+
+	server.InjectError(db2saasfake.RouteGetAllowlist, db2saasfake.Fault{
+		StatusCode: http.StatusServiceUnavailable,
+		Message:    "try again later",
+		Times:      1,
+	})
+
+	_, response, err := service.GetDb2SaasAllowlist(service.NewGetDb2SaasAllowlistOptions(testCRN))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "try again later")
+	assert.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+
+	_, _, err = service.GetDb2SaasAllowlist(service.NewGetDb2SaasAllowlistOptions(testCRN))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, server.Calls(db2saasfake.RouteGetAllowlist))
+
+	server.InjectError(db2saasfake.RouteGetBackups, db2saasfake.Fault{StatusCode: http.StatusInternalServerError})
+	for i := 0; i < 3; i++ {
+		_, _, err = service.GetDb2SaasBackup(service.NewGetDb2SaasBackupOptions(testProfile))
+		assert.NotNil(t, err)
+	}
+	server.ClearErrors()
+	_, _, err = service.GetDb2SaasBackup(service.NewGetDb2SaasBackupOptions(testProfile))
+	assert.Nil(t, err)
+}
+
+func TestMissingDeploymentHeader(t *testing.T) {
+	server, _ := newTestServer(t)
+
+	response, err := http.Get(server.URL + "/manage/backups")
+	require.Nil(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+}
+
+func TestBackupPolls(t *testing.T) {
+	server, service := newTestServer(t)
+	server.BackupPolls = 1
+
+	_, _, err := service.PostDb2SaasBackup(service.NewPostDb2SaasBackupOptions(testProfile))
+	require.Nil(t, err)
+
+	for _, expected := range []string{db2saasv1.Backup_Status_InProgress, db2saasv1.Backup_Status_Completed} {
+		backups, _, err := service.GetDb2SaasBackup(service.NewGetDb2SaasBackupOptions(testProfile))
+		require.Nil(t, err)
+		assert.Equal(t, expected, *backups.Backups[0].Status)
+	}
+}
+
+func TestRestores(t *testing.T) {
+	server, service := newTestServer(t)
+	server.RestorePolls = 1
+
+	_, response, err := service.PostDb2SaasRestore(service.NewPostDb2SaasRestoreOptions(testProfile))
+	assert.NotNil(t, err)
+	assert.Nil(t, response)
+	assert.Equal(t, 0, server.Calls(db2saasfake.RoutePostRestore))
+
+	_, response, err = service.PostDb2SaasRestore(service.NewPostDb2SaasRestoreOptions(testProfile).SetBackupID("missing"))
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+	_, response, err = service.PostDb2SaasRestore(service.NewPostDb2SaasRestoreOptions(testProfile).SetTargetTime("yesterday"))
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+	created, _, err := service.PostDb2SaasRestore(service.NewPostDb2SaasRestoreOptions(testProfile).SetTargetTime("2025-01-01T00:00:00Z"))
+	require.Nil(t, err)
+
+	for _, expected := range []string{db2saasv1.Restore_Status_InProgress, db2saasv1.Restore_Status_Completed} {
+		restores, _, err := service.GetDb2SaasRestore(service.NewGetDb2SaasRestoreOptions(testProfile))
+		require.Nil(t, err)
+		require.Len(t, restores.Restores, 1)
+		assert.Equal(t, *created.Task.ID, *restores.Restores[0].ID)
+		assert.Equal(t, "2025-01-01T00:00:00Z", *restores.Restores[0].TargetTime)
+		assert.Equal(t, expected, *restores.Restores[0].Status)
+	}
+}
+
+func TestBackupQuery(t *testing.T) {
+	server, service := newTestServer(t)
+	for i, createdAt := range []string{"2025-01-03T00:00:00Z", "2025-01-01T00:00:00Z", "2025-01-02T00:00:00Z"} {
+		server.AddBackup(testCRN, db2saasv1.Backup{
+			ID:        core.StringPtr(fmt.Sprintf("backup-%d", i)),
+			Type:      core.StringPtr(db2saasv1.Backup_Type_Scheduled),
+			Status:    core.StringPtr(db2saasv1.Backup_Status_Completed),
+			CreatedAt: core.StringPtr(createdAt),
+		})
+	}
+
+	createdAfter := strfmt.DateTime(time.Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC))
+	options := service.NewGetDb2SaasBackupOptions(testProfile).
+		SetSort(db2saasv1.GetDb2SaasBackupOptions_Sort_CreatedAt).
+		SetCreatedAfter(&createdAfter).
+		SetLimit(1)
+	backups, _, err := service.GetDb2SaasBackup(options)
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), *backups.TotalCount)
+	require.Len(t, backups.Backups, 1)
+	assert.Equal(t, "backup-2", *backups.Backups[0].ID)
+
+	start, err := backups.GetNextStart()
+	require.Nil(t, err)
+	require.NotNil(t, start)
+	backups, _, err = service.GetDb2SaasBackup(options.SetStart(*start))
+	require.Nil(t, err)
+	require.Len(t, backups.Backups, 1)
+	assert.Equal(t, "backup-0", *backups.Backups[0].ID)
+	assert.Nil(t, backups.Next)
+
+	_, response, err := service.GetDb2SaasBackup(service.NewGetDb2SaasBackupOptions(testProfile).SetSort("size"))
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+}
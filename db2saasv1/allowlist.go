@@ -0,0 +1,303 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	common "github.com/IBM/cloud-db2-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Actions of an AllowlistChange.
+const (
+	AllowlistChange_Action_Add               = "add"
+	AllowlistChange_Action_Remove            = "remove"
+	AllowlistChange_Action_UpdateDescription = "update_description"
+)
+
+// AllowlistChange : A difference between the current and the desired allowlist.
+type AllowlistChange struct {
+	// The kind of change.
+	Action string `json:"action"`
+
+	// The IP address the change applies to.
+	Address string `json:"address"`
+
+	// The description before the change. Empty for added addresses.
+	PreviousDescription string `json:"previous_description,omitempty"`
+
+	// The description after the change. Empty for removed addresses.
+	Description string `json:"description,omitempty"`
+}
+
+// AllowlistPlan : The changes that turn the current allowlist of a deployment into the desired one.
+//
+// A plan is only a preview until Apply is called. Since PostDb2SaasAllowlist replaces the whole list,
+// Apply first checks that the allowlist still matches Current and fails with an AllowlistConflictError
+// if another client changed it in the meantime.
+type AllowlistPlan struct {
+	// The allowlist when the plan was computed.
+	Current []IpAddress `json:"current"`
+
+	// The allowlist the plan converges to.
+	Desired []IpAddress `json:"desired"`
+
+	// The changes, additions and description updates in desired order followed by removals in current order.
+	Changes []AllowlistChange `json:"changes"`
+
+	service       *Db2saasV1
+	xDeploymentID *string
+	headers       map[string]string
+}
+
+// AllowlistConflictError is returned by AllowlistPlan.Apply when the allowlist changed after the plan was computed.
+type AllowlistConflictError struct {
+	// The allowlist the plan was computed against.
+	Expected []IpAddress
+
+	// The allowlist found when applying the plan.
+	Actual []IpAddress
+}
+
+func (e *AllowlistConflictError) Error() string {
+	return "the allowlist was modified after the plan was computed; reconcile again"
+}
+
+// HasChanges returns true if applying the plan would modify the allowlist.
+func (plan *AllowlistPlan) HasChanges() bool {
+	return len(plan.Changes) > 0
+}
+
+// WriteReport writes a human-readable preview of the plan to "w".
+func (plan *AllowlistPlan) WriteReport(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Allowlist plan: %d current, %d desired, %d changes\n", len(plan.Current), len(plan.Desired), len(plan.Changes))
+	if !plan.HasChanges() {
+		fmt.Fprintf(tw, "No changes. The allowlist is up to date.\n")
+		return tw.Flush()
+	}
+	fmt.Fprintf(tw, "\nACTION\tADDRESS\tDESCRIPTION\n")
+	for _, change := range plan.Changes {
+		switch change.Action {
+		case AllowlistChange_Action_Add:
+			fmt.Fprintf(tw, "+ %s\t%s\t%s\n", change.Action, change.Address, change.Description)
+		case AllowlistChange_Action_Remove:
+			fmt.Fprintf(tw, "- %s\t%s\t%s\n", change.Action, change.Address, change.PreviousDescription)
+		default:
+			fmt.Fprintf(tw, "~ %s\t%s\t%s -> %s\n", change.Action, change.Address, change.PreviousDescription, change.Description)
+		}
+	}
+	return tw.Flush()
+}
+
+// Apply replaces the allowlist with the desired one if the plan has changes. It fails with an
+// AllowlistConflictError, without modifying the allowlist, if the allowlist no longer matches Current.
+// The allowlist is retrieved again and then replaced with two separate requests, which the service does not
+// make atomic: a change made between them is overwritten.
+func (plan *AllowlistPlan) Apply(ctx context.Context) (result *SuccessPostAllowedlistIPs, err error) {
+	if plan.service == nil {
+		err = fmt.Errorf("the plan was not created by ReconcileAllowlist and cannot be applied")
+		err = core.SDKErrorf(err, "", "allowlist-plan-detached", common.GetComponentInfo())
+		return
+	}
+	if !plan.HasChanges() {
+		return
+	}
+
+	current, _, err := plan.service.GetDb2SaasAllowlistWithContext(ctx, &GetDb2SaasAllowlistOptions{
+		XDeploymentID: plan.xDeploymentID,
+		Headers:       plan.headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-allowlist-error")
+		return
+	}
+	if currentAddresses := allowlistEntries(current); len(diffAllowlist(plan.Current, currentAddresses)) > 0 {
+		err = &AllowlistConflictError{Expected: plan.Current, Actual: currentAddresses}
+		err = core.SDKErrorf(err, "", "allowlist-conflict", common.GetComponentInfo())
+		return
+	}
+
+	result, _, err = plan.service.PostDb2SaasAllowlistWithContext(ctx, &PostDb2SaasAllowlistOptions{
+		XDeploymentID: plan.xDeploymentID,
+		IpAddresses:   plan.Desired,
+		Headers:       plan.headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "post-allowlist-error")
+	}
+	return
+}
+
+// allowlistEntries returns the entries of "allowlist", or an empty list if the service returned none.
+func allowlistEntries(allowlist *SuccessGetAllowlistIPs) []IpAddress {
+	if allowlist == nil || allowlist.IpAddresses == nil {
+		return []IpAddress{}
+	}
+	return allowlist.IpAddresses
+}
+
+// allowlistKey returns the address an entry is matched on: its normalized form, so that "10.0.0.1" and
+// "10.0.0.1/32" match, or the trimmed address if it does not parse.
+func allowlistKey(ipAddress *IpAddress) string {
+	address := strings.TrimSpace(core.StringNilMapper(ipAddress.Address))
+	if prefix, err := parseIpAddress(address); err == nil {
+		return formatPrefix(prefix)
+	}
+	return address
+}
+
+// diffAllowlist returns the changes that turn "current" into "desired". Entries are matched on their address.
+func diffAllowlist(current []IpAddress, desired []IpAddress) (changes []AllowlistChange) {
+	changes = []AllowlistChange{}
+	currentByAddress := make(map[string]*IpAddress, len(current))
+	for i := range current {
+		currentByAddress[allowlistKey(&current[i])] = &current[i]
+	}
+	desiredAddresses := make(map[string]bool, len(desired))
+	for i := range desired {
+		address := allowlistKey(&desired[i])
+		desiredAddresses[address] = true
+		description := core.StringNilMapper(desired[i].Description)
+		existing, ok := currentByAddress[address]
+		if !ok {
+			changes = append(changes, AllowlistChange{
+				Action:      AllowlistChange_Action_Add,
+				Address:     address,
+				Description: description,
+			})
+		} else if previous := core.StringNilMapper(existing.Description); previous != description {
+			changes = append(changes, AllowlistChange{
+				Action:              AllowlistChange_Action_UpdateDescription,
+				Address:             address,
+				PreviousDescription: previous,
+				Description:         description,
+			})
+		}
+	}
+	for i := range current {
+		if address := allowlistKey(&current[i]); !desiredAddresses[address] {
+			changes = append(changes, AllowlistChange{
+				Action:              AllowlistChange_Action_Remove,
+				Address:             address,
+				PreviousDescription: core.StringNilMapper(current[i].Description),
+			})
+		}
+	}
+	return
+}
+
+// ReconcileAllowlistOptions : The ReconcileAllowlist options.
+type ReconcileAllowlistOptions struct {
+	// CRN deployment id.
+	XDeploymentID *string `json:"x-deployment-id" validate:"required"`
+
+	// The desired allowlist. An empty, non-nil list removes every address.
+	IpAddresses []IpAddress `json:"ip_addresses" validate:"required"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewReconcileAllowlistOptions : Instantiate ReconcileAllowlistOptions
+func (*Db2saasV1) NewReconcileAllowlistOptions(xDeploymentID string, ipAddresses []IpAddress) *ReconcileAllowlistOptions {
+	return &ReconcileAllowlistOptions{
+		XDeploymentID: core.StringPtr(xDeploymentID),
+		IpAddresses:   ipAddresses,
+	}
+}
+
+// NewReconcileAllowlistOptionsForDeployment : Instantiate ReconcileAllowlistOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewReconcileAllowlistOptionsForDeployment(ref *DeploymentRef, ipAddresses []IpAddress) *ReconcileAllowlistOptions {
+	return db2saas.NewReconcileAllowlistOptions(ref.CRN(), ipAddresses)
+}
+
+// SetXDeploymentID : Allow user to set XDeploymentID
+func (_options *ReconcileAllowlistOptions) SetXDeploymentID(xDeploymentID string) *ReconcileAllowlistOptions {
+	_options.XDeploymentID = core.StringPtr(xDeploymentID)
+	return _options
+}
+
+// SetIpAddresses : Allow user to set IpAddresses
+func (_options *ReconcileAllowlistOptions) SetIpAddresses(ipAddresses []IpAddress) *ReconcileAllowlistOptions {
+	_options.IpAddresses = ipAddresses
+	return _options
+}
+
+// SetDeployment : Allow user to set XDeploymentID from a DeploymentRef
+func (_options *ReconcileAllowlistOptions) SetDeployment(ref *DeploymentRef) *ReconcileAllowlistOptions {
+	_options.XDeploymentID = core.StringPtr(ref.CRN())
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ReconcileAllowlistOptions) SetHeaders(param map[string]string) *ReconcileAllowlistOptions {
+	options.Headers = param
+	return options
+}
+
+// ReconcileAllowlist : Compute the changes that turn the allowlist of a deployment into the desired one
+func (db2saas *Db2saasV1) ReconcileAllowlist(reconcileAllowlistOptions *ReconcileAllowlistOptions) (result *AllowlistPlan, err error) {
+	result, err = db2saas.ReconcileAllowlistWithContext(context.Background(), reconcileAllowlistOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ReconcileAllowlistWithContext is an alternate form of the ReconcileAllowlist method which supports a Context parameter.
+// It validates the desired allowlist (see ValidateAllowlist), retrieves the current allowlist and returns a plan;
+// nothing is modified until the plan is applied.
+func (db2saas *Db2saasV1) ReconcileAllowlistWithContext(ctx context.Context, reconcileAllowlistOptions *ReconcileAllowlistOptions) (result *AllowlistPlan, err error) {
+	err = core.ValidateNotNil(reconcileAllowlistOptions, "reconcileAllowlistOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(reconcileAllowlistOptions, "reconcileAllowlistOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	err = ValidateAllowlist(reconcileAllowlistOptions.IpAddresses)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "allowlist-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	current, _, err := db2saas.GetDb2SaasAllowlistWithContext(ctx, &GetDb2SaasAllowlistOptions{
+		XDeploymentID: reconcileAllowlistOptions.XDeploymentID,
+		Headers:       reconcileAllowlistOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-allowlist-error")
+		return
+	}
+	currentAddresses := allowlistEntries(current)
+	result = &AllowlistPlan{
+		Current:       currentAddresses,
+		Desired:       reconcileAllowlistOptions.IpAddresses,
+		Changes:       diffAllowlist(currentAddresses, reconcileAllowlistOptions.IpAddresses),
+		service:       db2saas,
+		xDeploymentID: reconcileAllowlistOptions.XDeploymentID,
+		headers:       reconcileAllowlistOptions.Headers,
+	}
+	return
+}
@@ -0,0 +1,331 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	common "github.com/IBM/cloud-db2-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"gopkg.in/yaml.v3"
+)
+
+// Formats understood by ReadAllowlist and WriteAllowlist.
+//
+// CSV has an "address" and an optional "description" column, with an optional "address,description"
+// header. YAML is a list of mappings with "address" and "description" keys, either at the top level
+// or under an "ip_addresses" key. Text has one address per line, optionally followed by "# description".
+// In CSV and text, blank lines and lines starting with "#" are ignored, and whitespace around addresses
+// and descriptions is trimmed.
+const (
+	AllowlistFormat_CSV  = "csv"
+	AllowlistFormat_Text = "text"
+	AllowlistFormat_YAML = "yaml"
+)
+
+// AllowlistImportError : The reason an allowlist could not be read.
+type AllowlistImportError struct {
+	// The format being read.
+	Format string
+
+	// The 1-based line the error was found on, or 0 if it does not apply to a single line.
+	Line int
+
+	// The underlying error.
+	Err error
+}
+
+func (e *AllowlistImportError) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("%s allowlist, line %d: %s", e.Format, e.Line, e.Err)
+	}
+	return fmt.Sprintf("%s allowlist: %s", e.Format, e.Err)
+}
+
+func (e *AllowlistImportError) Unwrap() error {
+	return e.Err
+}
+
+// allowlistEntry is the YAML representation of an IpAddress.
+type allowlistEntry struct {
+	Address     string `yaml:"address"`
+	Description string `yaml:"description"`
+}
+
+// AllowlistFormatForPath returns the format of an allowlist file from its extension:
+// ".csv", ".yaml" or ".yml", and ".txt" or ".text".
+func AllowlistFormatForPath(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return AllowlistFormat_CSV, nil
+	case ".yaml", ".yml":
+		return AllowlistFormat_YAML, nil
+	case ".txt", ".text":
+		return AllowlistFormat_Text, nil
+	}
+	err := fmt.Errorf("cannot tell the allowlist format of '%s' from its extension", path)
+	return "", core.SDKErrorf(err, "", "unknown-allowlist-format", common.GetComponentInfo())
+}
+
+// ReadAllowlist reads an allowlist in "format" from "r". Addresses are normalized as by NormalizeIpAddress
+// and the result is checked with ValidateAllowlist, so it can be passed to NewPostDb2SaasAllowlistOptions
+// or NewReconcileAllowlistOptions as is.
+func ReadAllowlist(r io.Reader, format string) (ipAddresses []IpAddress, err error) {
+	var entries []allowlistEntry
+	var lines []int
+	switch format {
+	case AllowlistFormat_CSV:
+		entries, lines, err = readAllowlistCSV(r)
+	case AllowlistFormat_YAML:
+		entries, lines, err = readAllowlistYAML(r)
+	case AllowlistFormat_Text:
+		entries, lines, err = readAllowlistText(r)
+	default:
+		err = fmt.Errorf("unknown allowlist format '%s'", format)
+		err = core.SDKErrorf(err, "", "unknown-allowlist-format", common.GetComponentInfo())
+		return
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "allowlist-import-error", common.GetComponentInfo())
+		return
+	}
+
+	ipAddresses = make([]IpAddress, len(entries))
+	for i, entry := range entries {
+		prefix, parseErr := parseIpAddress(entry.Address)
+		if parseErr != nil {
+			err = &AllowlistImportError{Format: format, Line: lines[i], Err: parseErr}
+			err = core.SDKErrorf(err, "", "allowlist-import-error", common.GetComponentInfo())
+			return nil, err
+		}
+		ipAddresses[i] = IpAddress{
+			Address:     core.StringPtr(formatPrefix(prefix)),
+			Description: core.StringPtr(entry.Description),
+		}
+	}
+	if validateErr := ValidateAllowlist(ipAddresses); validateErr != nil {
+		importErr := &AllowlistImportError{Format: format, Err: validateErr}
+		var validationErr *AllowlistValidationError
+		if errors.As(validateErr, &validationErr) && len(validationErr.Errors) > 0 {
+			if index := validationErr.Errors[0].Index; index >= 0 && index < len(lines) {
+				importErr.Line = lines[index]
+			}
+		}
+		err = importErr
+		err = core.SDKErrorf(err, "", "allowlist-import-error", common.GetComponentInfo())
+		return nil, err
+	}
+	return
+}
+
+// ReadAllowlistFile reads an allowlist from a file whose format is given by its extension. See AllowlistFormatForPath.
+func ReadAllowlistFile(path string) (ipAddresses []IpAddress, err error) {
+	format, err := AllowlistFormatForPath(path)
+	if err != nil {
+		return
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "allowlist-import-error", common.GetComponentInfo())
+		return
+	}
+	defer f.Close()
+	return ReadAllowlist(f, format)
+}
+
+func readAllowlistCSV(r io.Reader) (entries []allowlistEntry, lines []int, err error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	for first := true; ; first = false {
+		record, readErr := reader.Read()
+		if readErr == io.EOF {
+			return
+		}
+		if readErr != nil {
+			importErr := &AllowlistImportError{Format: AllowlistFormat_CSV, Err: readErr}
+			var parseErr *csv.ParseError
+			if errors.As(readErr, &parseErr) {
+				importErr.Line, importErr.Err = parseErr.Line, parseErr.Err
+			}
+			return nil, nil, importErr
+		}
+		line, _ := reader.FieldPos(0)
+		if first && strings.EqualFold(strings.TrimSpace(record[0]), "address") {
+			continue
+		}
+		if len(record) > 2 {
+			err = fmt.Errorf("expected at most 2 fields, found %d", len(record))
+			return nil, nil, &AllowlistImportError{Format: AllowlistFormat_CSV, Line: line, Err: err}
+		}
+		entry := allowlistEntry{Address: record[0]}
+		if len(record) == 2 {
+			entry.Description = strings.TrimSpace(record[1])
+		}
+		entries = append(entries, entry)
+		lines = append(lines, line)
+	}
+}
+
+func readAllowlistYAML(r io.Reader) (entries []allowlistEntry, lines []int, err error) {
+	var document yaml.Node
+	if err = yaml.NewDecoder(r).Decode(&document); err != nil {
+		if err == io.EOF {
+			return nil, nil, nil
+		}
+		return nil, nil, &AllowlistImportError{Format: AllowlistFormat_YAML, Err: err}
+	}
+	root := document.Content[0]
+	if root.Kind == yaml.MappingNode {
+		var list *yaml.Node
+		for i := 0; i+1 < len(root.Content); i += 2 {
+			if root.Content[i].Value == "ip_addresses" {
+				list = root.Content[i+1]
+			} else {
+				err = fmt.Errorf("unexpected key '%s'", root.Content[i].Value)
+				return nil, nil, &AllowlistImportError{Format: AllowlistFormat_YAML, Line: root.Content[i].Line, Err: err}
+			}
+		}
+		if list == nil {
+			err = fmt.Errorf("missing 'ip_addresses' key")
+			return nil, nil, &AllowlistImportError{Format: AllowlistFormat_YAML, Line: root.Line, Err: err}
+		}
+		root = list
+	}
+	if root.Kind == yaml.ScalarNode && root.Tag == "!!null" {
+		return nil, nil, nil
+	}
+	if root.Kind != yaml.SequenceNode {
+		err = fmt.Errorf("expected a list of addresses")
+		return nil, nil, &AllowlistImportError{Format: AllowlistFormat_YAML, Line: root.Line, Err: err}
+	}
+	for _, item := range root.Content {
+		var entry allowlistEntry
+		if item.Kind == yaml.ScalarNode {
+			entry.Address = item.Value
+		} else if err = item.Decode(&entry); err != nil {
+			return nil, nil, &AllowlistImportError{Format: AllowlistFormat_YAML, Line: item.Line, Err: err}
+		}
+		entries = append(entries, entry)
+		lines = append(lines, item.Line)
+	}
+	return
+}
+
+func readAllowlistText(r io.Reader) (entries []allowlistEntry, lines []int, err error) {
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		address, description, _ := strings.Cut(scanner.Text(), "#")
+		address = strings.TrimSpace(address)
+		if address == "" {
+			continue
+		}
+		if fields := strings.Fields(address); len(fields) > 1 {
+			err = fmt.Errorf("expected one address per line, found '%s'", address)
+			return nil, nil, &AllowlistImportError{Format: AllowlistFormat_Text, Line: line, Err: err}
+		}
+		entries = append(entries, allowlistEntry{Address: address, Description: strings.TrimSpace(description)})
+		lines = append(lines, line)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, nil, &AllowlistImportError{Format: AllowlistFormat_Text, Err: err}
+	}
+	return
+}
+
+// WriteAllowlist writes an allowlist in "format" to "w". Output written in any format can be read back by
+// ReadAllowlist with the descriptions preserved, except that the text format cannot hold descriptions that
+// span several lines.
+func WriteAllowlist(w io.Writer, ipAddresses []IpAddress, format string) (err error) {
+	switch format {
+	case AllowlistFormat_CSV:
+		writer := csv.NewWriter(w)
+		records := [][]string{{"address", "description"}}
+		for i := range ipAddresses {
+			records = append(records, []string{
+				core.StringNilMapper(ipAddresses[i].Address),
+				core.StringNilMapper(ipAddresses[i].Description),
+			})
+		}
+		err = writer.WriteAll(records)
+	case AllowlistFormat_YAML:
+		document := struct {
+			IpAddresses []allowlistEntry `yaml:"ip_addresses"`
+		}{IpAddresses: []allowlistEntry{}}
+		for i := range ipAddresses {
+			document.IpAddresses = append(document.IpAddresses, allowlistEntry{
+				Address:     core.StringNilMapper(ipAddresses[i].Address),
+				Description: core.StringNilMapper(ipAddresses[i].Description),
+			})
+		}
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err = encoder.Encode(&document); err == nil {
+			err = encoder.Close()
+		}
+	case AllowlistFormat_Text:
+		bw := bufio.NewWriter(w)
+		for i := range ipAddresses {
+			address := core.StringNilMapper(ipAddresses[i].Address)
+			description := core.StringNilMapper(ipAddresses[i].Description)
+			if strings.ContainsAny(description, "\r\n") {
+				err = fmt.Errorf("ip_addresses[%d]: the description of '%s' spans several lines", i, address)
+				break
+			}
+			if description != "" {
+				fmt.Fprintf(bw, "%s # %s\n", address, description)
+			} else {
+				fmt.Fprintf(bw, "%s\n", address)
+			}
+		}
+		if err == nil {
+			err = bw.Flush()
+		}
+	default:
+		err = fmt.Errorf("unknown allowlist format '%s'", format)
+		return core.SDKErrorf(err, "", "unknown-allowlist-format", common.GetComponentInfo())
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "allowlist-export-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// WriteAllowlistFile writes an allowlist to a file whose format is given by its extension. See AllowlistFormatForPath.
+func WriteAllowlistFile(path string, ipAddresses []IpAddress) (err error) {
+	format, err := AllowlistFormatForPath(path)
+	if err != nil {
+		return
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return core.SDKErrorf(err, "", "allowlist-export-error", common.GetComponentInfo())
+	}
+	err = WriteAllowlist(f, ipAddresses, format)
+	if closeErr := f.Close(); err == nil && closeErr != nil {
+		err = core.SDKErrorf(closeErr, "", "allowlist-export-error", common.GetComponentInfo())
+	}
+	return
+}
@@ -0,0 +1,155 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IBM/cloud-db2-go-sdk/db2saasfake"
+	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Allowlist import and export`, func() {
+	newIpAddress := func(address string, description string) db2saasv1.IpAddress {
+		return db2saasv1.IpAddress{Address: core.StringPtr(address), Description: core.StringPtr(description)}
+	}
+	ipAddresses := []db2saasv1.IpAddress{
+		newIpAddress("10.0.0.0/24", "office, floor 2"),
+		newIpAddress("10.1.0.7", `vpn "gateway"`),
+		newIpAddress("2001:db8::/64", ""),
+		newIpAddress("192.168.1.1", "lab # 3"),
+	}
+	formats := []string{db2saasv1.AllowlistFormat_CSV, db2saasv1.AllowlistFormat_YAML, db2saasv1.AllowlistFormat_Text}
+
+	Describe(`WriteAllowlist and ReadAllowlist`, func() {
+		It(`Round-trip every format successfully`, func() {
+			for _, format := range formats {
+				var buffer bytes.Buffer
+				Expect(db2saasv1.WriteAllowlist(&buffer, ipAddresses, format)).To(BeNil(), format)
+				read, err := db2saasv1.ReadAllowlist(&buffer, format)
+				Expect(err).To(BeNil(), format)
+				Expect(read).To(Equal(ipAddresses), format)
+			}
+		})
+		It(`Invoke WriteAllowlist successfully`, func() {
+			var buffer bytes.Buffer
+			Expect(db2saasv1.WriteAllowlist(&buffer, ipAddresses[:2], db2saasv1.AllowlistFormat_CSV)).To(BeNil())
+			Expect(buffer.String()).To(Equal("address,description\n10.0.0.0/24,\"office, floor 2\"\n10.1.0.7,\"vpn \"\"gateway\"\"\"\n"))
+
+			buffer.Reset()
+			Expect(db2saasv1.WriteAllowlist(&buffer, ipAddresses[1:3], db2saasv1.AllowlistFormat_Text)).To(BeNil())
+			Expect(buffer.String()).To(Equal("10.1.0.7 # vpn \"gateway\"\n2001:db8::/64\n"))
+
+			buffer.Reset()
+			Expect(db2saasv1.WriteAllowlist(&buffer, ipAddresses[:1], db2saasv1.AllowlistFormat_YAML)).To(BeNil())
+			Expect(buffer.String()).To(Equal("ip_addresses:\n  - address: 10.0.0.0/24\n    description: office, floor 2\n"))
+
+			buffer.Reset()
+			Expect(db2saasv1.WriteAllowlist(&buffer, nil, db2saasv1.AllowlistFormat_YAML)).To(BeNil())
+			Expect(buffer.String()).To(Equal("ip_addresses: []\n"))
+		})
+		It(`Invoke ReadAllowlist successfully`, func() {
+			csvInput := "# exported by the network team\nAddress,Description\n10.0.0.5/24, office\n\n2001:DB8::1/128\n"
+			read, err := db2saasv1.ReadAllowlist(strings.NewReader(csvInput), db2saasv1.AllowlistFormat_CSV)
+			Expect(err).To(BeNil())
+			Expect(read).To(Equal([]db2saasv1.IpAddress{newIpAddress("10.0.0.0/24", "office"), newIpAddress("2001:db8::1", "")}))
+
+			yamlInput := "- address: 10.0.0.0/24\n  description: office\n- 2001:db8::1\n"
+			read, err = db2saasv1.ReadAllowlist(strings.NewReader(yamlInput), db2saasv1.AllowlistFormat_YAML)
+			Expect(err).To(BeNil())
+			Expect(read).To(Equal([]db2saasv1.IpAddress{newIpAddress("10.0.0.0/24", "office"), newIpAddress("2001:db8::1", "")}))
+
+			textInput := "# allowed ranges\n10.0.0.0/24   # office\n\n  2001:db8::1\n"
+			read, err = db2saasv1.ReadAllowlist(strings.NewReader(textInput), db2saasv1.AllowlistFormat_Text)
+			Expect(err).To(BeNil())
+			Expect(read).To(Equal([]db2saasv1.IpAddress{newIpAddress("10.0.0.0/24", "office"), newIpAddress("2001:db8::1", "")}))
+
+			for _, format := range formats {
+				read, err = db2saasv1.ReadAllowlist(strings.NewReader(""), format)
+				Expect(err).To(BeNil(), format)
+				Expect(read).ToNot(BeNil(), format)
+				Expect(read).To(BeEmpty(), format)
+			}
+		})
+		It(`Invoke ReadAllowlist with error`, func() {
+			for _, tc := range []struct {
+				format string
+				input  string
+				line   int
+			}{
+				{db2saasv1.AllowlistFormat_CSV, "address,description\n10.0.0.1,a\n10.0.0.300,b\n", 3},
+				{db2saasv1.AllowlistFormat_CSV, "10.0.0.1,a,extra\n", 1},
+				{db2saasv1.AllowlistFormat_CSV, "10.0.0.1,\"unterminated\n", 1},
+				{db2saasv1.AllowlistFormat_CSV, "10.0.0.0/8,a\n10.1.0.0/16,b\n", 2},
+				{db2saasv1.AllowlistFormat_YAML, "- address: 10.0.0.1\n- address: bogus\n", 2},
+				{db2saasv1.AllowlistFormat_YAML, "ranges:\n  - 10.0.0.1\n", 1},
+				{db2saasv1.AllowlistFormat_YAML, "address: 10.0.0.1\n", 1},
+				{db2saasv1.AllowlistFormat_YAML, "ip_addresses: 10.0.0.1\n", 1},
+				{db2saasv1.AllowlistFormat_Text, "10.0.0.1\n10.0.0.2 10.0.0.3\n", 2},
+				{db2saasv1.AllowlistFormat_Text, "10.0.0.1\n\n10.0.0.1/32 # again\n", 3},
+			} {
+				_, err := db2saasv1.ReadAllowlist(strings.NewReader(tc.input), tc.format)
+				Expect(err).ToNot(BeNil(), tc.input)
+				var importErr *db2saasv1.AllowlistImportError
+				Expect(errors.As(err, &importErr)).To(BeTrue(), tc.input)
+				Expect(importErr.Format).To(Equal(tc.format))
+				Expect(importErr.Line).To(Equal(tc.line), tc.input)
+			}
+
+			_, err := db2saasv1.ReadAllowlist(strings.NewReader("10.0.0.1"), "json")
+			Expect(err).ToNot(BeNil())
+			Expect(db2saasv1.WriteAllowlist(&bytes.Buffer{}, ipAddresses, "json")).ToNot(BeNil())
+			Expect(db2saasv1.WriteAllowlist(&bytes.Buffer{}, []db2saasv1.IpAddress{newIpAddress("10.0.0.1", "two\nlines")},
+				db2saasv1.AllowlistFormat_Text)).ToNot(BeNil())
+		})
+	})
+	Describe(`WriteAllowlistFile and ReadAllowlistFile`, func() {
+		It(`Round-trip allowlist files and post them successfully`, func() {
+			dir, err := os.MkdirTemp("", "allowlist")
+			Expect(err).To(BeNil())
+			defer os.RemoveAll(dir)
+
+			fakeServer := db2saasfake.NewServer()
+			defer fakeServer.Close()
+			db2saasService, err := fakeServer.NewService()
+			Expect(err).To(BeNil())
+
+			for _, name := range []string{"allowlist.csv", "allowlist.yml", "allowlist.YAML", "allowlist.txt"} {
+				path := filepath.Join(dir, name)
+				Expect(db2saasv1.WriteAllowlistFile(path, ipAddresses)).To(BeNil(), name)
+				read, err := db2saasv1.ReadAllowlistFile(path)
+				Expect(err).To(BeNil(), name)
+
+				_, _, err = db2saasService.PostDb2SaasAllowlist(db2saasService.NewPostDb2SaasAllowlistOptions(fakeCRN, read))
+				Expect(err).To(BeNil(), name)
+				Expect(fakeServer.Allowlist(fakeCRN)).To(Equal(ipAddresses), name)
+			}
+
+			_, err = db2saasv1.AllowlistFormatForPath("allowlist.json")
+			Expect(err).ToNot(BeNil())
+			_, err = db2saasv1.ReadAllowlistFile(filepath.Join(dir, "missing.csv"))
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
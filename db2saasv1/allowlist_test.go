@@ -0,0 +1,171 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/IBM/cloud-db2-go-sdk/db2saasfake"
+	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Allowlist`, func() {
+	newIpAddress := func(address string, description string) db2saasv1.IpAddress {
+		return db2saasv1.IpAddress{Address: core.StringPtr(address), Description: core.StringPtr(description)}
+	}
+	current := []db2saasv1.IpAddress{
+		newIpAddress("10.0.0.1", "office"),
+		newIpAddress("10.0.0.2", "vpn"),
+		newIpAddress("192.168.1.0/24", "lab"),
+	}
+	desired := []db2saasv1.IpAddress{
+		newIpAddress("10.0.0.1", "office"),
+		newIpAddress("10.0.0.2", "vpn gateway"),
+		newIpAddress("172.16.0.5", "ci runner"),
+	}
+
+	var fakeServer *db2saasfake.Server
+	var db2saasService *db2saasv1.Db2saasV1
+	BeforeEach(func() {
+		var err error
+		fakeServer = db2saasfake.NewServer()
+		db2saasService, err = fakeServer.NewService()
+		Expect(err).To(BeNil())
+		_, _, err = db2saasService.PostDb2SaasAllowlist(db2saasService.NewPostDb2SaasAllowlistOptions(fakeCRN, current))
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		fakeServer.Close()
+	})
+
+	Describe(`ReconcileAllowlist(reconcileAllowlistOptions *ReconcileAllowlistOptions)`, func() {
+		It(`Invoke ReconcileAllowlist successfully`, func() {
+			plan, err := db2saasService.ReconcileAllowlist(db2saasService.NewReconcileAllowlistOptions(fakeCRN, desired))
+			Expect(err).To(BeNil())
+			Expect(plan.HasChanges()).To(BeTrue())
+			Expect(plan.Current).To(Equal(current))
+			Expect(plan.Changes).To(Equal([]db2saasv1.AllowlistChange{
+				{Action: db2saasv1.AllowlistChange_Action_UpdateDescription, Address: "10.0.0.2", PreviousDescription: "vpn", Description: "vpn gateway"},
+				{Action: db2saasv1.AllowlistChange_Action_Add, Address: "172.16.0.5", Description: "ci runner"},
+				{Action: db2saasv1.AllowlistChange_Action_Remove, Address: "192.168.1.0/24", PreviousDescription: "lab"},
+			}))
+
+			var report bytes.Buffer
+			Expect(plan.WriteReport(&report)).To(BeNil())
+			Expect(report.String()).To(ContainSubstring("Allowlist plan: 3 current, 3 desired, 3 changes"))
+			Expect(report.String()).To(MatchRegexp(`~ update_description\s+10.0.0.2\s+vpn -> vpn gateway`))
+			Expect(report.String()).To(MatchRegexp(`\+ add\s+172.16.0.5\s+ci runner`))
+			Expect(report.String()).To(MatchRegexp(`- remove\s+192.168.1.0/24\s+lab`))
+
+			// Previewing does not modify the allowlist.
+			Expect(fakeServer.Allowlist(fakeCRN)).To(Equal(current))
+			Expect(fakeServer.Calls(db2saasfake.RoutePostAllowlist)).To(Equal(1))
+
+			result, err := plan.Apply(context.Background())
+			Expect(err).To(BeNil())
+			Expect(*result.Status).To(Equal("success"))
+			Expect(fakeServer.Allowlist(fakeCRN)).To(Equal(desired))
+
+			plan, err = db2saasService.ReconcileAllowlist(db2saasService.NewReconcileAllowlistOptions(fakeCRN, desired))
+			Expect(err).To(BeNil())
+			Expect(plan.HasChanges()).To(BeFalse())
+			report.Reset()
+			Expect(plan.WriteReport(&report)).To(BeNil())
+			Expect(report.String()).To(ContainSubstring("No changes"))
+			result, err = plan.Apply(context.Background())
+			Expect(err).To(BeNil())
+			Expect(result).To(BeNil())
+			Expect(fakeServer.Calls(db2saasfake.RoutePostAllowlist)).To(Equal(2))
+		})
+		It(`Invoke ReconcileAllowlist through an instance handle`, func() {
+			instance, err := db2saasService.Instance(fakeCRN)
+			Expect(err).To(BeNil())
+			plan, err := instance.Allowlist().Reconcile([]db2saasv1.IpAddress{})
+			Expect(err).To(BeNil())
+			Expect(plan.Changes).To(HaveLen(3))
+			_, err = plan.Apply(context.Background())
+			Expect(err).To(BeNil())
+			Expect(fakeServer.Allowlist(fakeCRN)).To(BeEmpty())
+		})
+		It(`Invoke ReconcileAllowlist with a DeploymentRef`, func() {
+			ref, err := db2saasv1.ParseDeploymentRef(fakeCRN)
+			Expect(err).To(BeNil())
+			plan, err := db2saasService.ReconcileAllowlist(db2saasService.NewReconcileAllowlistOptionsForDeployment(ref, desired))
+			Expect(err).To(BeNil())
+			Expect(plan.Changes).ToNot(BeEmpty())
+		})
+		It(`Invoke ReconcileAllowlist with an empty response`, func() {
+			emptyService, testServer := newEmptyResponseService()
+			defer testServer.Close()
+			plan, err := emptyService.ReconcileAllowlist(emptyService.NewReconcileAllowlistOptions(fakeCRN, desired))
+			Expect(err).To(BeNil())
+			Expect(plan.Current).To(BeEmpty())
+			Expect(plan.Changes).To(HaveLen(3))
+			_, err = plan.Apply(context.Background())
+			Expect(err).To(BeNil())
+		})
+		It(`Invoke Apply with error: Concurrent modification`, func() {
+			plan, err := db2saasService.ReconcileAllowlist(db2saasService.NewReconcileAllowlistOptions(fakeCRN, desired))
+			Expect(err).To(BeNil())
+
+			concurrent := append([]db2saasv1.IpAddress{newIpAddress("10.9.9.9", "other tool")}, current...)
+			_, _, err = db2saasService.PostDb2SaasAllowlist(db2saasService.NewPostDb2SaasAllowlistOptions(fakeCRN, concurrent))
+			Expect(err).To(BeNil())
+
+			_, err = plan.Apply(context.Background())
+			Expect(err).ToNot(BeNil())
+			var conflictErr *db2saasv1.AllowlistConflictError
+			Expect(errors.As(err, &conflictErr)).To(BeTrue())
+			Expect(conflictErr.Actual).To(Equal(concurrent))
+			Expect(fakeServer.Allowlist(fakeCRN)).To(Equal(concurrent))
+		})
+		It(`Invoke Apply with error: Detached plan`, func() {
+			plan, err := db2saasService.ReconcileAllowlist(db2saasService.NewReconcileAllowlistOptions(fakeCRN, desired))
+			Expect(err).To(BeNil())
+			b, err := json.Marshal(plan)
+			Expect(err).To(BeNil())
+
+			var decoded db2saasv1.AllowlistPlan
+			Expect(json.Unmarshal(b, &decoded)).To(BeNil())
+			Expect(decoded.Changes).To(Equal(plan.Changes))
+			_, err = decoded.Apply(context.Background())
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Invoke ReconcileAllowlist with error: Operation validation`, func() {
+			plan, err := db2saasService.ReconcileAllowlist(nil)
+			Expect(err).ToNot(BeNil())
+			Expect(plan).To(BeNil())
+
+			plan, err = db2saasService.ReconcileAllowlist(db2saasService.NewReconcileAllowlistOptions(fakeCRN, nil))
+			Expect(err).ToNot(BeNil())
+			Expect(plan).To(BeNil())
+
+			duplicates := []db2saasv1.IpAddress{newIpAddress("10.0.0.1", "a"), newIpAddress(" 10.0.0.1", "b")}
+			plan, err = db2saasService.ReconcileAllowlist(db2saasService.NewReconcileAllowlistOptions(fakeCRN, duplicates))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("duplicates ip_addresses[0]"))
+			Expect(plan).To(BeNil())
+			Expect(fakeServer.Calls(db2saasfake.RouteGetAllowlist)).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,398 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	common "github.com/IBM/cloud-db2-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Retention rules evaluated by BackupRetention, in the order they are applied.
+const (
+	BackupRetention_Rule_Daily   = "daily"
+	BackupRetention_Rule_Weekly  = "weekly"
+	BackupRetention_Rule_Monthly = "monthly"
+	BackupRetention_Rule_Yearly  = "yearly"
+)
+
+// Reasons recorded for backups that are kept or removed regardless of the retention rules.
+const (
+	BackupRetention_Reason_InProgress    = "in progress"
+	BackupRetention_Reason_UnknownTime   = "unknown creation time"
+	BackupRetention_Reason_Failed        = "failed"
+	BackupRetention_Reason_OutsidePolicy = "outside policy"
+)
+
+// BackupRetention : A grandfather-father-son retention policy, e.g. "keep 7 daily, 4 weekly, 12 monthly".
+//
+// For each rule the newest completed backup of each of the most recent N periods (days, ISO weeks,
+// months or years) is kept. A backup may be kept by several rules. Backups that are still in progress
+// or whose creation time cannot be parsed are always kept; failed backups are always removed.
+//
+// A policy that keeps no backup, e.g. the zero value, is rejected unless AllowRemoveAll is set.
+type BackupRetention struct {
+	// The number of daily backups to keep.
+	KeepDaily int `json:"keep_daily"`
+
+	// The number of weekly backups to keep.
+	KeepWeekly int `json:"keep_weekly"`
+
+	// The number of monthly backups to keep.
+	KeepMonthly int `json:"keep_monthly"`
+
+	// The number of yearly backups to keep.
+	KeepYearly int `json:"keep_yearly"`
+
+	// Whether a policy that keeps no backup may be evaluated, removing every completed backup.
+	AllowRemoveAll bool `json:"allow_remove_all,omitempty"`
+
+	// The time zone in which period boundaries are computed. Defaults to UTC. It is serialized as its name, so a
+	// policy read from JSON needs a name that time.LoadLocation knows, e.g. "Europe/Berlin".
+	Location *time.Location `json:"-"`
+}
+
+// MarshalJSON adds the name of Location as the "location" property.
+func (retention BackupRetention) MarshalJSON() ([]byte, error) {
+	type backupRetention BackupRetention
+	document := struct {
+		backupRetention
+		Location string `json:"location,omitempty"`
+	}{backupRetention: backupRetention(retention)}
+	if retention.Location != nil {
+		document.Location = retention.Location.String()
+	}
+	return json.Marshal(document)
+}
+
+// UnmarshalJSON loads the "location" property with time.LoadLocation.
+func (retention *BackupRetention) UnmarshalJSON(data []byte) error {
+	type backupRetention BackupRetention
+	document := struct {
+		*backupRetention
+		Location string `json:"location,omitempty"`
+	}{backupRetention: (*backupRetention)(retention)}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return err
+	}
+	retention.Location = nil
+	if document.Location != "" {
+		location, err := time.LoadLocation(document.Location)
+		if err != nil {
+			return fmt.Errorf("invalid location '%s': %w", document.Location, err)
+		}
+		retention.Location = location
+	}
+	return nil
+}
+
+// BackupRetentionDecision : The outcome of the retention policy for one backup.
+type BackupRetentionDecision struct {
+	Backup Backup `json:"backup"`
+
+	// The rules and periods that keep the backup, e.g. "monthly 2025-01", or why it is removed.
+	Reasons []string `json:"reasons"`
+}
+
+// BackupRetentionShortfall : A rule that is not met because too few backups exist.
+type BackupRetentionShortfall struct {
+	Rule     string `json:"rule"`
+	Required int    `json:"required"`
+	Found    int    `json:"found"`
+}
+
+// BackupRetentionPlan : The result of evaluating a retention policy against a set of backups.
+// Plans are JSON-serializable so they can be archived as evidence of compliance.
+type BackupRetentionPlan struct {
+	EvaluatedAt time.Time                  `json:"evaluated_at"`
+	Policy      BackupRetention            `json:"policy"`
+	Keep        []BackupRetentionDecision  `json:"keep"`
+	Remove      []BackupRetentionDecision  `json:"remove"`
+	Shortfalls  []BackupRetentionShortfall `json:"shortfalls,omitempty"`
+}
+
+// Validate checks that no count is negative and that the policy keeps at least one backup, unless
+// AllowRemoveAll is set.
+func (retention *BackupRetention) Validate() error {
+	counts := []struct {
+		name string
+		keep int
+	}{
+		{"keep_daily", retention.KeepDaily},
+		{"keep_weekly", retention.KeepWeekly},
+		{"keep_monthly", retention.KeepMonthly},
+		{"keep_yearly", retention.KeepYearly},
+	}
+	total := 0
+	for _, count := range counts {
+		if count.keep < 0 {
+			return fmt.Errorf("invalid %s %d: must not be negative", count.name, count.keep)
+		}
+		total += count.keep
+	}
+	if total == 0 && !retention.AllowRemoveAll {
+		return fmt.Errorf("the retention policy keeps no backup and would remove every completed backup; set allow_remove_all to allow it")
+	}
+	return nil
+}
+
+// period returns the key identifying the period of "t" for a rule.
+func (retention *BackupRetention) period(rule string, t time.Time) string {
+	location := retention.Location
+	if location == nil {
+		location = time.UTC
+	}
+	t = t.In(location)
+	switch rule {
+	case BackupRetention_Rule_Daily:
+		return t.Format("2006-01-02")
+	case BackupRetention_Rule_Weekly:
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	case BackupRetention_Rule_Monthly:
+		return t.Format("2006-01")
+	default:
+		return t.Format("2006")
+	}
+}
+
+// Plan evaluates the policy against "backups" and returns which backups to keep and which to remove.
+// It does not modify anything. The policy is checked with Validate first.
+func (retention *BackupRetention) Plan(backups []Backup) (plan *BackupRetentionPlan, err error) {
+	if err = retention.Validate(); err != nil {
+		err = core.SDKErrorf(err, "", "retention-validation-error", common.GetComponentInfo())
+		return
+	}
+	plan = &BackupRetentionPlan{
+		EvaluatedAt: time.Now().UTC(),
+		Policy:      *retention,
+		Keep:        []BackupRetentionDecision{},
+		Remove:      []BackupRetentionDecision{},
+	}
+
+	var candidates []Backup
+	for _, backup := range backups {
+		if _, err := backup.CreatedAtTime(); err != nil {
+			plan.Keep = append(plan.Keep, BackupRetentionDecision{backup, []string{BackupRetention_Reason_UnknownTime}})
+			continue
+		}
+		status := ""
+		if backup.Status != nil {
+			status = *backup.Status
+		}
+		switch status {
+		case Backup_Status_Completed:
+			candidates = append(candidates, backup)
+		case Backup_Status_Failed:
+			plan.Remove = append(plan.Remove, BackupRetentionDecision{backup, []string{BackupRetention_Reason_Failed}})
+		default:
+			plan.Keep = append(plan.Keep, BackupRetentionDecision{backup, []string{BackupRetention_Reason_InProgress}})
+		}
+	}
+	SortBackupsByCreatedAt(candidates, true)
+
+	reasons := make([][]string, len(candidates))
+	rules := []struct {
+		name string
+		keep int
+	}{
+		{BackupRetention_Rule_Daily, retention.KeepDaily},
+		{BackupRetention_Rule_Weekly, retention.KeepWeekly},
+		{BackupRetention_Rule_Monthly, retention.KeepMonthly},
+		{BackupRetention_Rule_Yearly, retention.KeepYearly},
+	}
+	for _, rule := range rules {
+		if rule.keep <= 0 {
+			continue
+		}
+		found := 0
+		last := ""
+		for i := range candidates {
+			if found == rule.keep {
+				break
+			}
+			createdAt, _ := candidates[i].CreatedAtTime()
+			period := retention.period(rule.name, createdAt)
+			if period == last {
+				continue
+			}
+			last = period
+			found++
+			reasons[i] = append(reasons[i], rule.name+" "+period)
+		}
+		if found < rule.keep {
+			plan.Shortfalls = append(plan.Shortfalls, BackupRetentionShortfall{rule.name, rule.keep, found})
+		}
+	}
+
+	for i, backup := range candidates {
+		if len(reasons[i]) > 0 {
+			plan.Keep = append(plan.Keep, BackupRetentionDecision{backup, reasons[i]})
+		} else {
+			plan.Remove = append(plan.Remove, BackupRetentionDecision{backup, []string{BackupRetention_Reason_OutsidePolicy}})
+		}
+	}
+	decisionBackup := func(decision *BackupRetentionDecision) *Backup { return &decision.Backup }
+	sortByCreatedAt(plan.Keep, decisionBackup, true)
+	sortByCreatedAt(plan.Remove, decisionBackup, true)
+	return
+}
+
+// Compliant returns true if every rule of the policy is met.
+func (plan *BackupRetentionPlan) Compliant() bool {
+	return len(plan.Shortfalls) == 0
+}
+
+// WriteReport writes a human-readable dry-run report of the plan to "w".
+func (plan *BackupRetentionPlan) WriteReport(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Backup retention plan evaluated at %s\n", plan.EvaluatedAt.Format(time.RFC3339))
+	fmt.Fprintf(tw, "Policy: keep %d daily, %d weekly, %d monthly, %d yearly\n",
+		plan.Policy.KeepDaily, plan.Policy.KeepWeekly, plan.Policy.KeepMonthly, plan.Policy.KeepYearly)
+	if plan.Compliant() {
+		fmt.Fprintf(tw, "Compliant: yes\n")
+	} else {
+		fmt.Fprintf(tw, "Compliant: no\n")
+		for _, shortfall := range plan.Shortfalls {
+			fmt.Fprintf(tw, "  %s: %d of %d required backups found\n", shortfall.Rule, shortfall.Found, shortfall.Required)
+		}
+	}
+	fmt.Fprintf(tw, "\nACTION\tID\tCREATED AT\tSTATUS\tREASONS\n")
+	for _, section := range []struct {
+		action    string
+		decisions []BackupRetentionDecision
+	}{{"keep", plan.Keep}, {"remove", plan.Remove}} {
+		for _, decision := range section.decisions {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%v\n", section.action,
+				core.StringNilMapper(decision.Backup.ID), core.StringNilMapper(decision.Backup.CreatedAt),
+				core.StringNilMapper(decision.Backup.Status), decision.Reasons)
+		}
+	}
+	return tw.Flush()
+}
+
+// Apply calls "deleteBackup" for every backup the plan removes and returns the errors joined together.
+// It is intended for use once the service supports deleting backups; until then, inspect the plan
+// or call WriteReport for a dry run. A plan whose policy does not pass Validate, e.g. one read back
+// from JSON, is not applied.
+func (plan *BackupRetentionPlan) Apply(ctx context.Context, deleteBackup func(context.Context, *Backup) error) error {
+	if err := plan.Policy.Validate(); err != nil {
+		return core.SDKErrorf(err, "", "retention-validation-error", common.GetComponentInfo())
+	}
+	var errs []error
+	for i := range plan.Remove {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+		if err := deleteBackup(ctx, &plan.Remove[i].Backup); err != nil {
+			errs = append(errs, fmt.Errorf("backup '%s': %w", core.StringNilMapper(plan.Remove[i].Backup.ID), err))
+		}
+	}
+	if len(errs) > 0 {
+		return core.SDKErrorf(errors.Join(errs...), "", "retention-apply-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// PlanBackupRetentionOptions : The PlanBackupRetention options.
+type PlanBackupRetentionOptions struct {
+	// Encoded CRN deployment id.
+	XDbProfile *string `json:"x-db-profile" validate:"required"`
+
+	// The retention policy to evaluate.
+	Retention *BackupRetention `json:"retention" validate:"required"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewPlanBackupRetentionOptions : Instantiate PlanBackupRetentionOptions
+func (*Db2saasV1) NewPlanBackupRetentionOptions(xDbProfile string, retention *BackupRetention) *PlanBackupRetentionOptions {
+	return &PlanBackupRetentionOptions{
+		XDbProfile: core.StringPtr(xDbProfile),
+		Retention:  retention,
+	}
+}
+
+// SetXDbProfile : Allow user to set XDbProfile
+func (_options *PlanBackupRetentionOptions) SetXDbProfile(xDbProfile string) *PlanBackupRetentionOptions {
+	_options.XDbProfile = core.StringPtr(xDbProfile)
+	return _options
+}
+
+// SetRetention : Allow user to set Retention
+func (_options *PlanBackupRetentionOptions) SetRetention(retention *BackupRetention) *PlanBackupRetentionOptions {
+	_options.Retention = retention
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *PlanBackupRetentionOptions) SetHeaders(param map[string]string) *PlanBackupRetentionOptions {
+	options.Headers = param
+	return options
+}
+
+// PlanBackupRetention : Evaluate a retention policy against the backups of an instance
+func (db2saas *Db2saasV1) PlanBackupRetention(planBackupRetentionOptions *PlanBackupRetentionOptions) (result *BackupRetentionPlan, err error) {
+	result, err = db2saas.PlanBackupRetentionWithContext(context.Background(), planBackupRetentionOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PlanBackupRetentionWithContext is an alternate form of the PlanBackupRetention method which supports a Context parameter.
+// It retrieves every backup of the instance and evaluates the retention policy against them.
+func (db2saas *Db2saasV1) PlanBackupRetentionWithContext(ctx context.Context, planBackupRetentionOptions *PlanBackupRetentionOptions) (result *BackupRetentionPlan, err error) {
+	err = core.ValidateNotNil(planBackupRetentionOptions, "planBackupRetentionOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(planBackupRetentionOptions, "planBackupRetentionOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	err = planBackupRetentionOptions.Retention.Validate()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "retention-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	pager, err := db2saas.NewGetDb2SaasBackupPager(&GetDb2SaasBackupOptions{
+		XDbProfile: planBackupRetentionOptions.XDbProfile,
+		Headers:    planBackupRetentionOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "pager-error")
+		return
+	}
+	backups, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-backup-error")
+		return
+	}
+	result, err = planBackupRetentionOptions.Retention.Plan(backups)
+	return
+}
@@ -0,0 +1,254 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/IBM/cloud-db2-go-sdk/db2saasfake"
+	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`BackupRetention`, func() {
+	newBackup := func(id string, status string, createdAt string) db2saasv1.Backup {
+		return db2saasv1.Backup{
+			ID:        core.StringPtr(id),
+			Type:      core.StringPtr(db2saasv1.Backup_Type_Scheduled),
+			Status:    core.StringPtr(status),
+			CreatedAt: core.StringPtr(createdAt),
+			Size:      core.Int64Ptr(0),
+			Duration:  core.Int64Ptr(0),
+		}
+	}
+	decisionIDs := func(decisions []db2saasv1.BackupRetentionDecision) (ids []string) {
+		for _, decision := range decisions {
+			ids = append(ids, *decision.Backup.ID)
+		}
+		return
+	}
+
+	// One completed backup per day from 2025-01-01 to 2025-03-01, plus a failed,
+	// an in-progress and an undated backup.
+	var backups []db2saasv1.Backup
+	for day := time.Date(2025, time.January, 1, 2, 0, 0, 0, time.UTC); day.Month() != time.March || day.Day() == 1; day = day.AddDate(0, 0, 1) {
+		backups = append(backups, newBackup(day.Format("2006-01-02"), db2saasv1.Backup_Status_Completed, day.Format(time.RFC3339)))
+	}
+	backups = append(backups,
+		newBackup("failed", db2saasv1.Backup_Status_Failed, "2025-03-01T05:00:00Z"),
+		newBackup("running", db2saasv1.Backup_Status_InProgress, "2025-03-01T06:00:00Z"),
+		newBackup("undated", db2saasv1.Backup_Status_Completed, "CreatedAt"),
+	)
+
+	Describe(`Plan(backups []Backup)`, func() {
+		It(`Invoke Plan successfully`, func() {
+			retention := &db2saasv1.BackupRetention{KeepDaily: 7, KeepWeekly: 4, KeepMonthly: 3, KeepYearly: 1}
+			plan, err := retention.Plan(backups)
+			Expect(err).To(BeNil())
+			Expect(plan.Compliant()).To(BeTrue())
+			Expect(decisionIDs(plan.Keep)).To(Equal([]string{
+				"running", "2025-03-01", "2025-02-28", "2025-02-27", "2025-02-26", "2025-02-25", "2025-02-24",
+				"2025-02-23", "2025-02-16", "2025-02-09", "2025-01-31", "undated",
+			}))
+			Expect(plan.Keep[1].Reasons).To(Equal([]string{"daily 2025-03-01", "weekly 2025-W09", "monthly 2025-03", "yearly 2025"}))
+			Expect(plan.Keep[0].Reasons).To(Equal([]string{db2saasv1.BackupRetention_Reason_InProgress}))
+			Expect(plan.Keep[11].Reasons).To(Equal([]string{db2saasv1.BackupRetention_Reason_UnknownTime}))
+
+			Expect(plan.Remove).To(HaveLen(51))
+			Expect(*plan.Remove[0].Backup.ID).To(Equal("failed"))
+			Expect(plan.Remove[0].Reasons).To(Equal([]string{db2saasv1.BackupRetention_Reason_Failed}))
+			Expect(*plan.Remove[1].Backup.ID).To(Equal("2025-02-22"))
+			Expect(plan.Remove[1].Reasons).To(Equal([]string{db2saasv1.BackupRetention_Reason_OutsidePolicy}))
+		})
+		It(`Invoke Plan and report shortfalls`, func() {
+			retention := &db2saasv1.BackupRetention{KeepDaily: 7, KeepMonthly: 12}
+			plan, err := retention.Plan(backups)
+			Expect(err).To(BeNil())
+			Expect(plan.Compliant()).To(BeFalse())
+			Expect(plan.Shortfalls).To(Equal([]db2saasv1.BackupRetentionShortfall{
+				{Rule: db2saasv1.BackupRetention_Rule_Monthly, Required: 12, Found: 3},
+			}))
+		})
+		It(`Invoke Plan with a time zone`, func() {
+			monthEnd := []db2saasv1.Backup{
+				newBackup("late-january", db2saasv1.Backup_Status_Completed, "2025-01-31T23:30:00Z"),
+				newBackup("early-february", db2saasv1.Backup_Status_Completed, "2025-02-01T10:00:00Z"),
+			}
+
+			plan, err := (&db2saasv1.BackupRetention{KeepMonthly: 2}).Plan(monthEnd)
+			Expect(err).To(BeNil())
+			Expect(decisionIDs(plan.Keep)).To(Equal([]string{"early-february", "late-january"}))
+
+			plan, err = (&db2saasv1.BackupRetention{KeepMonthly: 2, Location: time.FixedZone("UTC+2", 2*60*60)}).Plan(monthEnd)
+			Expect(err).To(BeNil())
+			Expect(decisionIDs(plan.Keep)).To(Equal([]string{"early-february"}))
+			Expect(decisionIDs(plan.Remove)).To(Equal([]string{"late-january"}))
+			Expect(plan.Compliant()).To(BeFalse())
+		})
+		It(`Invoke Plan with an empty policy`, func() {
+			plan, err := (&db2saasv1.BackupRetention{}).Plan(backups)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("would remove every completed backup"))
+			Expect(plan).To(BeNil())
+
+			plan, err = (&db2saasv1.BackupRetention{AllowRemoveAll: true}).Plan(backups)
+			Expect(err).To(BeNil())
+			Expect(plan.Compliant()).To(BeTrue())
+			Expect(decisionIDs(plan.Keep)).To(Equal([]string{"running", "undated"}))
+			Expect(plan.Remove).To(HaveLen(len(backups) - 2))
+		})
+		It(`Invoke Plan with a negative count`, func() {
+			plan, err := (&db2saasv1.BackupRetention{KeepDaily: 7, KeepWeekly: -1}).Plan(backups)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("keep_weekly"))
+			Expect(plan).To(BeNil())
+		})
+	})
+	Describe(`BackupRetentionPlan`, func() {
+		It(`Invoke WriteReport and marshal the plan successfully`, func() {
+			plan, err := (&db2saasv1.BackupRetention{KeepDaily: 1, KeepMonthly: 4}).Plan(backups)
+			Expect(err).To(BeNil())
+
+			var report bytes.Buffer
+			Expect(plan.WriteReport(&report)).To(BeNil())
+			Expect(report.String()).To(ContainSubstring("Policy: keep 1 daily, 0 weekly, 4 monthly, 0 yearly"))
+			Expect(report.String()).To(ContainSubstring("Compliant: no"))
+			Expect(report.String()).To(ContainSubstring("monthly: 3 of 4 required backups found"))
+			Expect(report.String()).To(MatchRegexp(`keep\s+2025-03-01\s+2025-03-01T02:00:00Z\s+completed\s+\[daily 2025-03-01 monthly 2025-03\]`))
+			Expect(report.String()).To(MatchRegexp(`remove\s+failed\s+`))
+
+			b, err := json.Marshal(plan)
+			Expect(err).To(BeNil())
+			var decoded db2saasv1.BackupRetentionPlan
+			Expect(json.Unmarshal(b, &decoded)).To(BeNil())
+			Expect(decoded.Policy.KeepMonthly).To(Equal(4))
+			Expect(decoded.Keep).To(Equal(plan.Keep))
+			Expect(decoded.Shortfalls).To(Equal(plan.Shortfalls))
+		})
+		It(`Marshal a policy with a time zone`, func() {
+			location, err := time.LoadLocation("Europe/Berlin")
+			Expect(err).To(BeNil())
+			b, err := json.Marshal(db2saasv1.BackupRetention{KeepMonthly: 2, Location: location})
+			Expect(err).To(BeNil())
+			Expect(string(b)).To(ContainSubstring(`"location":"Europe/Berlin"`))
+
+			var decoded db2saasv1.BackupRetention
+			Expect(json.Unmarshal(b, &decoded)).To(BeNil())
+			Expect(decoded.KeepMonthly).To(Equal(2))
+			Expect(decoded.Location).To(Equal(location))
+
+			b, err = json.Marshal(db2saasv1.BackupRetention{KeepDaily: 1})
+			Expect(err).To(BeNil())
+			Expect(string(b)).ToNot(ContainSubstring("location"))
+			decoded = db2saasv1.BackupRetention{Location: location}
+			Expect(json.Unmarshal(b, &decoded)).To(BeNil())
+			Expect(decoded.Location).To(BeNil())
+
+			err = json.Unmarshal([]byte(`{"keep_daily":1,"location":"Nowhere/Unknown"}`), &decoded)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid location 'Nowhere/Unknown'"))
+		})
+		It(`Invoke Apply successfully`, func() {
+			plan, err := (&db2saasv1.BackupRetention{KeepDaily: 7}).Plan(backups)
+			Expect(err).To(BeNil())
+
+			var deleted []string
+			err = plan.Apply(context.Background(), func(_ context.Context, backup *db2saasv1.Backup) error {
+				deleted = append(deleted, *backup.ID)
+				if *backup.ID == "2025-01-01" {
+					return fmt.Errorf("delete failed")
+				}
+				return nil
+			})
+			Expect(deleted).To(Equal(decisionIDs(plan.Remove)))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("backup '2025-01-01': delete failed"))
+		})
+		It(`Invoke Apply with a cancelled context`, func() {
+			plan, err := (&db2saasv1.BackupRetention{KeepDaily: 7}).Plan(backups)
+			Expect(err).To(BeNil())
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			calls := 0
+			err = plan.Apply(ctx, func(context.Context, *db2saasv1.Backup) error {
+				calls++
+				return nil
+			})
+			Expect(calls).To(BeZero())
+			Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+		})
+		It(`Invoke Apply with a policy that keeps no backup`, func() {
+			plan, err := (&db2saasv1.BackupRetention{AllowRemoveAll: true}).Plan(backups)
+			Expect(err).To(BeNil())
+			plan.Policy.AllowRemoveAll = false
+
+			calls := 0
+			err = plan.Apply(context.Background(), func(context.Context, *db2saasv1.Backup) error {
+				calls++
+				return nil
+			})
+			Expect(calls).To(BeZero())
+			Expect(err).ToNot(BeNil())
+		})
+	})
+	Describe(`PlanBackupRetention(planBackupRetentionOptions *PlanBackupRetentionOptions)`, func() {
+		It(`Invoke PlanBackupRetention successfully`, func() {
+			fakeServer := db2saasfake.NewServer()
+			defer fakeServer.Close()
+			db2saasService, err := fakeServer.NewService()
+			Expect(err).To(BeNil())
+			for _, backup := range backups {
+				fakeServer.AddBackup(fakeCRN, backup)
+			}
+
+			retention := &db2saasv1.BackupRetention{KeepDaily: 7, KeepWeekly: 4, KeepMonthly: 3, KeepYearly: 1}
+			planBackupRetentionOptionsModel := db2saasService.NewPlanBackupRetentionOptions(fakeProfile, retention)
+			plan, err := db2saasService.PlanBackupRetention(planBackupRetentionOptionsModel)
+			Expect(err).To(BeNil())
+			expected, err := retention.Plan(backups)
+			Expect(err).To(BeNil())
+			Expect(plan.Keep).To(Equal(expected.Keep))
+		})
+		It(`Invoke PlanBackupRetention with error: Operation validation`, func() {
+			db2saasService, err := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+				URL:           "http://db2saasv1modelgenerator.com",
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).To(BeNil())
+
+			plan, err := db2saasService.PlanBackupRetention(nil)
+			Expect(err).ToNot(BeNil())
+			Expect(plan).To(BeNil())
+
+			plan, err = db2saasService.PlanBackupRetention(db2saasService.NewPlanBackupRetentionOptions(fakeProfile, nil))
+			Expect(err).ToNot(BeNil())
+			Expect(plan).To(BeNil())
+
+			plan, err = db2saasService.PlanBackupRetention(db2saasService.NewPlanBackupRetentionOptions(fakeProfile, &db2saasv1.BackupRetention{}))
+			Expect(err).ToNot(BeNil())
+			Expect(plan).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,296 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	common "github.com/IBM/cloud-db2-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Backup statuses reported by GetDb2SaasBackup.
+const (
+	Backup_Status_Completed  = "completed"
+	Backup_Status_Failed     = "failed"
+	Backup_Status_InProgress = "in_progress"
+)
+
+// Backup types reported by GetDb2SaasBackup.
+const (
+	Backup_Type_OnDemand  = "on_demand"
+	Backup_Type_Scheduled = "scheduled"
+)
+
+// timestampLayouts are the layouts accepted for service timestamps, most specific first.
+// Layouts without a zone are interpreted as UTC.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+}
+
+// parseTimestamp parses a timestamp reported by the service and returns it in UTC.
+func parseTimestamp(value string) (time.Time, error) {
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized timestamp '%s'", value)
+}
+
+// CreatedAtTime returns the creation time of the backup in UTC.
+// Timestamps without a zone offset are interpreted as UTC.
+func (backup *Backup) CreatedAtTime() (time.Time, error) {
+	if backup == nil || backup.CreatedAt == nil {
+		err := fmt.Errorf("backup has no created_at timestamp")
+		return time.Time{}, core.SDKErrorf(err, "", "missing-created-at", common.GetComponentInfo())
+	}
+	t, err := parseTimestamp(*backup.CreatedAt)
+	if err != nil {
+		return time.Time{}, core.SDKErrorf(err, "", "invalid-created-at", common.GetComponentInfo())
+	}
+	return t, nil
+}
+
+// DurationValue returns the duration of the backup operation, or zero if it is not reported.
+func (backup *Backup) DurationValue() time.Duration {
+	if backup == nil || backup.Duration == nil {
+		return 0
+	}
+	return time.Duration(*backup.Duration) * time.Second
+}
+
+// SizeValue returns the size of the backup, or zero if it is not reported.
+func (backup *Backup) SizeValue() ByteSize {
+	if backup == nil || backup.Size == nil {
+		return 0
+	}
+	return ByteSize(*backup.Size)
+}
+
+// BackupFilter selects backups on the client side. Zero-valued fields do not restrict the selection.
+// Use it when the service ignores the filter options of GetDb2SaasBackupOptions, or to narrow a page further.
+type BackupFilter struct {
+	// Only select backups of one of these types.
+	Types []string
+
+	// Only select backups with one of these statuses.
+	Statuses []string
+
+	// Only select backups created at or after this time.
+	CreatedAfter time.Time
+
+	// Only select backups created before this time.
+	CreatedBefore time.Time
+}
+
+// Matches returns true if the backup satisfies every criterion of the filter.
+// A backup with an unparsable CreatedAt never matches a time window.
+func (filter *BackupFilter) Matches(backup *Backup) bool {
+	if filter == nil {
+		return true
+	}
+	if len(filter.Types) > 0 && (backup.Type == nil || !containsString(filter.Types, *backup.Type)) {
+		return false
+	}
+	if len(filter.Statuses) > 0 && (backup.Status == nil || !containsString(filter.Statuses, *backup.Status)) {
+		return false
+	}
+	if !filter.CreatedAfter.IsZero() || !filter.CreatedBefore.IsZero() {
+		createdAt, err := backup.CreatedAtTime()
+		if err != nil {
+			return false
+		}
+		if !filter.CreatedAfter.IsZero() && createdAt.Before(filter.CreatedAfter) {
+			return false
+		}
+		if !filter.CreatedBefore.IsZero() && !createdAt.Before(filter.CreatedBefore) {
+			return false
+		}
+	}
+	return true
+}
+
+// FilterBackups returns the backups that match the filter, preserving their order.
+func FilterBackups(backups []Backup, filter *BackupFilter) []Backup {
+	result := []Backup{}
+	for i := range backups {
+		if filter.Matches(&backups[i]) {
+			result = append(result, backups[i])
+		}
+	}
+	return result
+}
+
+// SortBackupsByCreatedAt sorts backups in place by creation time, oldest first unless "descending" is set.
+// Backups with an unparsable CreatedAt are placed last, in their original order.
+func SortBackupsByCreatedAt(backups []Backup, descending bool) {
+	sortByCreatedAt(backups, func(backup *Backup) *Backup { return backup }, descending)
+}
+
+// sortByCreatedAt stably sorts "items" by the creation time of the backup that "backup" returns for each.
+// Items with an unparsable creation time are placed last.
+func sortByCreatedAt[T any](items []T, backup func(*T) *Backup, descending bool) {
+	type entry struct {
+		item      T
+		createdAt time.Time
+		valid     bool
+	}
+	entries := make([]entry, len(items))
+	for i := range items {
+		createdAt, err := backup(&items[i]).CreatedAtTime()
+		entries[i] = entry{items[i], createdAt, err == nil}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if !entries[i].valid || !entries[j].valid {
+			return entries[i].valid && !entries[j].valid
+		}
+		if descending {
+			return entries[i].createdAt.After(entries[j].createdAt)
+		}
+		return entries[i].createdAt.Before(entries[j].createdAt)
+	})
+	for i := range entries {
+		items[i] = entries[i].item
+	}
+}
+
+// validateTarget checks that the options name exactly one restore target: a backup or a point in time.
+func (options *PostDb2SaasRestoreOptions) validateTarget() error {
+	hasBackup := options.BackupID != nil && *options.BackupID != ""
+	hasTime := options.TargetTime != nil && *options.TargetTime != ""
+	switch {
+	case !hasBackup && !hasTime:
+		return fmt.Errorf("a restore needs a backup_id or a target_time")
+	case hasBackup && hasTime:
+		return fmt.Errorf("a restore takes a backup_id or a target_time, not both")
+	}
+	return nil
+}
+
+// ByteSize is a size in bytes.
+type ByteSize int64
+
+// Binary size units.
+const (
+	Byte     ByteSize = 1
+	Kibibyte          = 1024 * Byte
+	Mebibyte          = 1024 * Kibibyte
+	Gibibyte          = 1024 * Mebibyte
+	Tebibyte          = 1024 * Gibibyte
+	Pebibyte          = 1024 * Tebibyte
+)
+
+// byteSizeUnits maps unit suffixes to their size, largest first so that String picks the best fit.
+var byteSizeUnits = []struct {
+	suffix string
+	size   ByteSize
+}{
+	{"PiB", Pebibyte},
+	{"TiB", Tebibyte},
+	{"GiB", Gibibyte},
+	{"MiB", Mebibyte},
+	{"KiB", Kibibyte},
+	{"B", Byte},
+}
+
+// byteSizeAliases maps the accepted, lower-cased spellings of each unit to its size.
+var byteSizeAliases = map[string]ByteSize{
+	"":    Byte,
+	"b":   Byte,
+	"k":   Kibibyte,
+	"kb":  Kibibyte,
+	"kib": Kibibyte,
+	"m":   Mebibyte,
+	"mb":  Mebibyte,
+	"mib": Mebibyte,
+	"g":   Gibibyte,
+	"gb":  Gibibyte,
+	"gib": Gibibyte,
+	"t":   Tebibyte,
+	"tb":  Tebibyte,
+	"tib": Tebibyte,
+	"p":   Pebibyte,
+	"pb":  Pebibyte,
+	"pib": Pebibyte,
+}
+
+// Bytes returns the size as a number of bytes.
+func (size ByteSize) Bytes() int64 {
+	return int64(size)
+}
+
+// In returns the size expressed in "unit", e.g. size.In(Gibibyte).
+func (size ByteSize) In(unit ByteSize) float64 {
+	return float64(size) / float64(unit)
+}
+
+// String formats the size using the largest binary unit that keeps the value at or above one, e.g. "1.5 GiB".
+func (size ByteSize) String() string {
+	abs := size
+	if abs < 0 {
+		abs = -abs
+	}
+	for _, unit := range byteSizeUnits {
+		if abs >= unit.size {
+			if unit.size == Byte {
+				return fmt.Sprintf("%d B", int64(size))
+			}
+			return trimFloat(size.In(unit.size)) + " " + unit.suffix
+		}
+	}
+	return "0 B"
+}
+
+// trimFloat formats a value with at most two decimals and without trailing zeros.
+func trimFloat(value float64) string {
+	s := strconv.FormatFloat(value, 'f', 2, 64)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// ParseByteSize parses a size such as "512", "10MB", "1.5 GiB" or "2t".
+// Units are case-insensitive and always binary: "MB" and "MiB" both mean 1024*1024 bytes.
+func ParseByteSize(value string) (ByteSize, error) {
+	s := strings.TrimSpace(value)
+	i := strings.IndexFunc(s, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if i < 0 {
+		i = len(s)
+	}
+	number, unit := s[:i], strings.ToLower(strings.TrimSpace(s[i:]))
+
+	size, ok := byteSizeAliases[unit]
+	if !ok {
+		err := fmt.Errorf("invalid size '%s': unknown unit '%s'", value, unit)
+		return 0, core.SDKErrorf(err, "", "invalid-byte-size", common.GetComponentInfo())
+	}
+	n, err := strconv.ParseFloat(number, 64)
+	if err != nil || n < 0 {
+		err = fmt.Errorf("invalid size '%s'", value)
+		return 0, core.SDKErrorf(err, "", "invalid-byte-size", common.GetComponentInfo())
+	}
+	return ByteSize(n * float64(size)), nil
+}
@@ -0,0 +1,171 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1_test
+
+import (
+	"time"
+
+	"github.com/IBM/cloud-db2-go-sdk/db2saasfake"
+	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Backups`, func() {
+	Describe(`Backup accessors`, func() {
+		It(`Invoke CreatedAtTime successfully`, func() {
+			expected := time.Date(2025, time.March, 4, 5, 6, 7, 0, time.UTC)
+			for _, createdAt := range []string{
+				"2025-03-04T05:06:07Z",
+				"2025-03-04T07:06:07+02:00",
+				"2025-03-04T05:06:07",
+				"2025-03-04 05:06:07",
+				"2025-03-04 00:06:07-05:00",
+			} {
+				backup := &db2saasv1.Backup{CreatedAt: core.StringPtr(createdAt)}
+				createdAtTime, err := backup.CreatedAtTime()
+				Expect(err).To(BeNil())
+				Expect(createdAtTime).To(Equal(expected), createdAt)
+				Expect(createdAtTime.Location()).To(Equal(time.UTC))
+			}
+
+			backup := &db2saasv1.Backup{CreatedAt: core.StringPtr("2025-03-04T05:06:07.250Z")}
+			createdAtTime, err := backup.CreatedAtTime()
+			Expect(err).To(BeNil())
+			Expect(createdAtTime.Nanosecond()).To(Equal(250000000))
+		})
+		It(`Invoke CreatedAtTime with error`, func() {
+			_, err := (&db2saasv1.Backup{}).CreatedAtTime()
+			Expect(err).ToNot(BeNil())
+
+			_, err = (&db2saasv1.Backup{CreatedAt: core.StringPtr("CreatedAt")}).CreatedAtTime()
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("CreatedAt"))
+		})
+		It(`Invoke DurationValue and SizeValue successfully`, func() {
+			backup := &db2saasv1.Backup{
+				Duration: core.Int64Ptr(90),
+				Size:     core.Int64Ptr(3 * 1024 * 1024),
+			}
+			Expect(backup.DurationValue()).To(Equal(90 * time.Second))
+			Expect(backup.SizeValue()).To(Equal(3 * db2saasv1.Mebibyte))
+
+			var nilBackup *db2saasv1.Backup
+			Expect(nilBackup.DurationValue()).To(BeZero())
+			Expect(new(db2saasv1.Backup).SizeValue()).To(BeZero())
+		})
+	})
+	Describe(`ByteSize`, func() {
+		It(`Invoke String successfully`, func() {
+			Expect(db2saasv1.ByteSize(0).String()).To(Equal("0 B"))
+			Expect(db2saasv1.ByteSize(512).String()).To(Equal("512 B"))
+			Expect(db2saasv1.Kibibyte.String()).To(Equal("1 KiB"))
+			Expect((3 * db2saasv1.Gibibyte / 2).String()).To(Equal("1.5 GiB"))
+			Expect((-2 * db2saasv1.Tebibyte).String()).To(Equal("-2 TiB"))
+			Expect(db2saasv1.ByteSize(1000).In(db2saasv1.Kibibyte)).To(BeNumerically("~", 0.9765, 0.0001))
+		})
+		It(`Invoke ParseByteSize successfully`, func() {
+			for value, expected := range map[string]db2saasv1.ByteSize{
+				"512":     512,
+				"10MB":    10 * db2saasv1.Mebibyte,
+				"10 mib":  10 * db2saasv1.Mebibyte,
+				"1.5 GiB": 3 * db2saasv1.Gibibyte / 2,
+				"2t":      2 * db2saasv1.Tebibyte,
+				" 1 PB ":  db2saasv1.Pebibyte,
+			} {
+				size, err := db2saasv1.ParseByteSize(value)
+				Expect(err).To(BeNil(), value)
+				Expect(size).To(Equal(expected), value)
+			}
+		})
+		It(`Invoke ParseByteSize with error`, func() {
+			for _, value := range []string{"", "GB", "10 parsecs", "1.2.3MB", "-5"} {
+				_, err := db2saasv1.ParseByteSize(value)
+				Expect(err).ToNot(BeNil(), value)
+			}
+		})
+	})
+	Describe(`Backup filtering and sorting`, func() {
+		newBackup := func(id string, backupType string, status string, createdAt string) db2saasv1.Backup {
+			return db2saasv1.Backup{
+				ID:        core.StringPtr(id),
+				Type:      core.StringPtr(backupType),
+				Status:    core.StringPtr(status),
+				CreatedAt: core.StringPtr(createdAt),
+			}
+		}
+		ids := func(backups []db2saasv1.Backup) (result []string) {
+			for _, backup := range backups {
+				result = append(result, *backup.ID)
+			}
+			return
+		}
+		backups := []db2saasv1.Backup{
+			newBackup("b", db2saasv1.Backup_Type_Scheduled, db2saasv1.Backup_Status_Completed, "2025-01-02T00:00:00Z"),
+			newBackup("a", db2saasv1.Backup_Type_Scheduled, db2saasv1.Backup_Status_Failed, "2025-01-01T00:00:00Z"),
+			newBackup("x", db2saasv1.Backup_Type_OnDemand, db2saasv1.Backup_Status_Completed, "CreatedAt"),
+			newBackup("c", db2saasv1.Backup_Type_OnDemand, db2saasv1.Backup_Status_Completed, "2025-01-03T01:00:00+02:00"),
+		}
+
+		It(`Invoke FilterBackups successfully`, func() {
+			Expect(ids(db2saasv1.FilterBackups(backups, nil))).To(Equal([]string{"b", "a", "x", "c"}))
+			Expect(ids(db2saasv1.FilterBackups(backups, &db2saasv1.BackupFilter{
+				Types: []string{db2saasv1.Backup_Type_OnDemand},
+			}))).To(Equal([]string{"x", "c"}))
+			Expect(ids(db2saasv1.FilterBackups(backups, &db2saasv1.BackupFilter{
+				Statuses: []string{db2saasv1.Backup_Status_Completed},
+			}))).To(Equal([]string{"b", "x", "c"}))
+			Expect(ids(db2saasv1.FilterBackups(backups, &db2saasv1.BackupFilter{
+				CreatedAfter:  time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC),
+				CreatedBefore: time.Date(2025, time.January, 2, 23, 30, 0, 0, time.UTC),
+			}))).To(Equal([]string{"b", "c"}))
+			Expect(db2saasv1.FilterBackups(backups, &db2saasv1.BackupFilter{Types: []string{"unknown"}})).To(BeEmpty())
+		})
+		It(`Invoke SortBackupsByCreatedAt successfully`, func() {
+			sorted := append([]db2saasv1.Backup{}, backups...)
+			db2saasv1.SortBackupsByCreatedAt(sorted, false)
+			Expect(ids(sorted)).To(Equal([]string{"a", "b", "c", "x"}))
+			db2saasv1.SortBackupsByCreatedAt(sorted, true)
+			Expect(ids(sorted)).To(Equal([]string{"c", "b", "a", "x"}))
+		})
+		It(`Use GetDb2SaasBackupPager with server-side filters`, func() {
+			fakeServer := db2saasfake.NewServer()
+			defer fakeServer.Close()
+			db2saasService, err := fakeServer.NewService()
+			Expect(err).To(BeNil())
+			for _, backup := range backups {
+				fakeServer.AddBackup(fakeCRN, backup)
+			}
+
+			getDb2SaasBackupOptionsModel := db2saasService.NewGetDb2SaasBackupOptions(fakeProfile)
+			getDb2SaasBackupOptionsModel.SetStatus(db2saasv1.Backup_Status_Completed)
+			getDb2SaasBackupOptionsModel.SetSort(db2saasv1.GetDb2SaasBackupOptions_Sort_CreatedAtDesc)
+			getDb2SaasBackupOptionsModel.SetLimit(1)
+			pager, err := db2saasService.NewGetDb2SaasBackupPager(getDb2SaasBackupOptionsModel)
+			Expect(err).To(BeNil())
+
+			var pages [][]string
+			for pager.HasNext() {
+				page, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				pages = append(pages, ids(page))
+			}
+			Expect(pages).To(Equal([][]string{{"c"}, {"b"}, {"x"}}))
+		})
+	})
+})
@@ -0,0 +1,116 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1
+
+import (
+	"reflect"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The models returned by GetDb2SaasTuneableParam and those sent by PostDb2SaasDbConfiguration have the same
+// properties, so each can be converted to the other: the conversions below fail to compile if they diverge.
+// Converted values share no memory with the original.
+
+// ToCustomSettings returns the parameters as settings that PostDb2SaasDbConfiguration accepts.
+func (params *SuccessTuneableParamsTuneableParamDb) ToCustomSettings() *CreateCustomSettingsDb {
+	if params == nil {
+		return nil
+	}
+	return cloneSettings(CreateCustomSettingsDb(*params))
+}
+
+// ToTuneableParams returns the settings as the parameters GetDb2SaasTuneableParam reports once they are applied.
+func (settings *CreateCustomSettingsDb) ToTuneableParams() *SuccessTuneableParamsTuneableParamDb {
+	if settings == nil {
+		return nil
+	}
+	return cloneSettings(SuccessTuneableParamsTuneableParamDb(*settings))
+}
+
+// ToCustomSettings returns the parameters as settings that PostDb2SaasDbConfiguration accepts.
+func (params *SuccessTuneableParamsTuneableParamDbm) ToCustomSettings() *CreateCustomSettingsDbm {
+	if params == nil {
+		return nil
+	}
+	return cloneSettings(CreateCustomSettingsDbm(*params))
+}
+
+// ToTuneableParams returns the settings as the parameters GetDb2SaasTuneableParam reports once they are applied.
+func (settings *CreateCustomSettingsDbm) ToTuneableParams() *SuccessTuneableParamsTuneableParamDbm {
+	if settings == nil {
+		return nil
+	}
+	return cloneSettings(SuccessTuneableParamsTuneableParamDbm(*settings))
+}
+
+// ToCustomSettings returns the parameters as settings that PostDb2SaasDbConfiguration accepts.
+func (params *SuccessTuneableParamsTuneableParamRegistry) ToCustomSettings() *CreateCustomSettingsRegistry {
+	if params == nil {
+		return nil
+	}
+	return cloneSettings(CreateCustomSettingsRegistry(*params))
+}
+
+// ToTuneableParams returns the settings as the parameters GetDb2SaasTuneableParam reports once they are applied.
+func (settings *CreateCustomSettingsRegistry) ToTuneableParams() *SuccessTuneableParamsTuneableParamRegistry {
+	if settings == nil {
+		return nil
+	}
+	return cloneSettings(SuccessTuneableParamsTuneableParamRegistry(*settings))
+}
+
+// NewPostDb2SaasDbConfigurationOptionsFromTuneableParams : Instantiate PostDb2SaasDbConfigurationOptions that apply
+// the parameters of "params", as returned by GetDb2SaasTuneableParam for any deployment, to the deployment
+// "xDbProfile". Settings the parameters leave unset are not sent.
+func (*Db2saasV1) NewPostDb2SaasDbConfigurationOptionsFromTuneableParams(xDbProfile string, params *SuccessTuneableParams) *PostDb2SaasDbConfigurationOptions {
+	options := &PostDb2SaasDbConfigurationOptions{
+		XDbProfile: core.StringPtr(xDbProfile),
+	}
+	if params != nil && params.TuneableParam != nil {
+		options.Registry = params.TuneableParam.Registry.ToCustomSettings()
+		options.Db = params.TuneableParam.Db.ToCustomSettings()
+		options.Dbm = params.TuneableParam.Dbm.ToCustomSettings()
+	}
+	return options
+}
+
+// ToTuneableParams returns the settings of the options as the parameters GetDb2SaasTuneableParam reports once
+// they are applied.
+func (_options *PostDb2SaasDbConfigurationOptions) ToTuneableParams() *SuccessTuneableParams {
+	return &SuccessTuneableParams{
+		TuneableParam: &SuccessTuneableParamsTuneableParam{
+			Registry: _options.Registry.ToTuneableParams(),
+			Db:       _options.Db.ToTuneableParams(),
+			Dbm:      _options.Dbm.ToTuneableParams(),
+		},
+	}
+}
+
+// cloneSettings returns a pointer to "settings", a settings model, after giving each of its properties that is set
+// a copy of its value.
+func cloneSettings[T any](settings T) *T {
+	value := reflect.ValueOf(&settings).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		if field := value.Field(i); field.Kind() == reflect.Pointer && !field.IsNil() {
+			copied := reflect.New(field.Type().Elem())
+			copied.Elem().Set(field.Elem())
+			field.Set(copied)
+		}
+	}
+	return &settings
+}
@@ -0,0 +1,155 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/IBM/cloud-db2-go-sdk/db2saasfake"
+	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fillSettings sets every property of a settings model to a distinct value.
+func fillSettings(settings interface{}) {
+	value := reflect.ValueOf(settings).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		value.Field(i).Set(reflect.ValueOf(core.StringPtr(fmt.Sprintf("value-%d", i))))
+	}
+}
+
+var _ = Describe(`Tuneable parameter conversions`, func() {
+	It(`Convert settings without loss`, func() {
+		db := &db2saasv1.SuccessTuneableParamsTuneableParamDb{}
+		dbm := &db2saasv1.SuccessTuneableParamsTuneableParamDbm{}
+		registry := &db2saasv1.SuccessTuneableParamsTuneableParamRegistry{}
+		fillSettings(db)
+		fillSettings(dbm)
+		fillSettings(registry)
+
+		for _, test := range []struct {
+			params    interface{}
+			settings  interface{}
+			roundTrip interface{}
+		}{
+			{db, db.ToCustomSettings(), db.ToCustomSettings().ToTuneableParams()},
+			{dbm, dbm.ToCustomSettings(), dbm.ToCustomSettings().ToTuneableParams()},
+			{registry, registry.ToCustomSettings(), registry.ToCustomSettings().ToTuneableParams()},
+		} {
+			Expect(test.roundTrip).To(Equal(test.params))
+			paramsJSON, err := json.Marshal(test.params)
+			Expect(err).To(BeNil())
+			settingsJSON, err := json.Marshal(test.settings)
+			Expect(err).To(BeNil())
+			Expect(settingsJSON).To(MatchJSON(paramsJSON))
+		}
+	})
+	It(`Convert settings without sharing memory`, func() {
+		params := &db2saasv1.SuccessTuneableParamsTuneableParamDb{LOCKTIMEOUT: core.StringPtr("30")}
+		settings := params.ToCustomSettings()
+		Expect(settings).To(Equal(&db2saasv1.CreateCustomSettingsDb{LOCKTIMEOUT: core.StringPtr("30")}))
+		*settings.LOCKTIMEOUT = "60"
+		Expect(*params.LOCKTIMEOUT).To(Equal("30"))
+
+		var nilParams *db2saasv1.SuccessTuneableParamsTuneableParamDbm
+		Expect(nilParams.ToCustomSettings()).To(BeNil())
+		var nilSettings *db2saasv1.CreateCustomSettingsRegistry
+		Expect(nilSettings.ToTuneableParams()).To(BeNil())
+	})
+	It(`Convert options`, func() {
+		db2saasService, err := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+			URL:           "https://db2saasv1/api",
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+
+		options := db2saasService.NewPostDb2SaasDbConfigurationOptionsFromTuneableParams(fakeProfile, nil)
+		Expect(options).To(Equal(db2saasService.NewPostDb2SaasDbConfigurationOptions(fakeProfile)))
+
+		params := &db2saasv1.SuccessTuneableParams{
+			TuneableParam: &db2saasv1.SuccessTuneableParamsTuneableParam{
+				Db:       &db2saasv1.SuccessTuneableParamsTuneableParamDb{LOCKTIMEOUT: core.StringPtr("30")},
+				Registry: &db2saasv1.SuccessTuneableParamsTuneableParamRegistry{DB2WORKLOAD: core.StringPtr("SAP")},
+			},
+		}
+		options = db2saasService.NewPostDb2SaasDbConfigurationOptionsFromTuneableParams(fakeProfile, params)
+		Expect(*options.XDbProfile).To(Equal(fakeProfile))
+		Expect(*options.Db.LOCKTIMEOUT).To(Equal("30"))
+		Expect(*options.Registry.DB2WORKLOAD).To(Equal("SAP"))
+		Expect(options.Dbm).To(BeNil())
+		Expect(options.ToTuneableParams()).To(Equal(params))
+	})
+	It(`Clone the configuration of an instance onto another`, func() {
+		fakeServer := db2saasfake.NewServer()
+		defer fakeServer.Close()
+		db2saasService, err := fakeServer.NewService()
+		Expect(err).To(BeNil())
+		source := fakeCRN
+		target := strings.Replace(fakeCRN, "39269573", "00000000", 1)
+		fakeServer.SetSetting(source, "db", "LOCKTIMEOUT", "45")
+		fakeServer.SetSetting(source, "dbm", "COMM_BANDWIDTH", "100")
+		fakeServer.SetSetting(source, "registry", "DB2_WORKLOAD", "ANALYTICS")
+		fakeServer.SetSetting(target, "db", "LOCKTIMEOUT", "30")
+
+		snapshot, _, err := db2saasService.GetDb2SaasTuneableParam(db2saasService.NewGetDb2SaasTuneableParamOptions().SetXDbProfile(fakeProfile))
+		Expect(err).To(BeNil())
+		options := db2saasService.NewPostDb2SaasDbConfigurationOptionsFromTuneableParams(url.QueryEscape(target), snapshot)
+		_, _, err = db2saasService.PostDb2SaasDbConfiguration(options)
+		Expect(err).To(BeNil())
+
+		cloned, _, err := db2saasService.GetDb2SaasTuneableParam(db2saasService.NewGetDb2SaasTuneableParamOptions().SetXDbProfile(url.QueryEscape(target)))
+		Expect(err).To(BeNil())
+		Expect(cloned).To(Equal(snapshot))
+	})
+	It(`Replay a snapshot with Db2 default values`, func() {
+		fakeServer := db2saasfake.NewServer()
+		defer fakeServer.Close()
+		db2saasService, err := fakeServer.NewService()
+		Expect(err).To(BeNil())
+		fakeServer.SetSetting(fakeCRN, "db", "LOCKTIMEOUT", "-1")
+		fakeServer.SetSetting(fakeCRN, "db", "DFT_DEGREE", "-1")
+		fakeServer.SetSetting(fakeCRN, "db", "LOG_DISK_CAP", "0")
+		fakeServer.SetSetting(fakeCRN, "dbm", "MAX_QUERYDEGREE", "-1")
+
+		deployment, err := db2saasv1.ParseDeploymentRef(fakeCRN)
+		Expect(err).To(BeNil())
+		snapshot, _, err := db2saasService.GetDb2SaasTuneableParam(db2saasService.NewGetDb2SaasTuneableParamOptionsForDeployment(deployment))
+		Expect(err).To(BeNil())
+		options := db2saasService.NewPostDb2SaasDbConfigurationOptionsFromTuneableParams(deployment.DbProfile(), snapshot)
+		Expect(options.ValidateSettings()).To(BeNil())
+		_, _, err = db2saasService.PostDb2SaasDbConfiguration(options)
+		Expect(err).To(BeNil())
+		Expect(fakeServer.Calls(db2saasfake.RoutePostDbConfiguration)).To(Equal(1))
+
+		for _, setting := range []struct{ scope, name, value string }{
+			{"db", "LOCKTIMEOUT", "-1"},
+			{"db", "DFT_DEGREE", "-1"},
+			{"db", "LOG_DISK_CAP", "0"},
+			{"dbm", "MAX_QUERYDEGREE", "-1"},
+		} {
+			value, ok := fakeServer.Setting(fakeCRN, setting.scope, setting.name)
+			Expect(ok).To(BeTrue())
+			Expect(value).To(Equal(setting.value))
+		}
+	})
+})
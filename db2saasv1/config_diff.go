@@ -0,0 +1,175 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// ConfigChange : A custom setting whose value a PostDb2SaasDbConfiguration call changes.
+type ConfigChange struct {
+	// The name of the setting, as sent to the service, such as "UTIL_HEAP_SZ".
+	Name string `json:"name"`
+
+	// The name of the property of the settings models, such as "UTILHEAPSZ".
+	Property string `json:"property"`
+
+	// The current value, or nil if the service reports none.
+	Old *string `json:"old"`
+
+	// The desired value.
+	New string `json:"new"`
+}
+
+// ConfigChangeset : The custom settings a PostDb2SaasDbConfiguration call changes, by scope. Settings are listed
+// in the order of the properties of the settings models.
+type ConfigChangeset struct {
+	Registry []ConfigChange `json:"registry,omitempty"`
+
+	Db []ConfigChange `json:"db,omitempty"`
+
+	Dbm []ConfigChange `json:"dbm,omitempty"`
+
+	// The number of desired settings that already have their value.
+	Unchanged int `json:"unchanged"`
+}
+
+// HasChanges returns true if the changeset changes any setting.
+func (changeset *ConfigChangeset) HasChanges() bool {
+	return changeset.Len() > 0
+}
+
+// Len returns the number of settings the changeset changes.
+func (changeset *ConfigChangeset) Len() int {
+	return len(changeset.Registry) + len(changeset.Db) + len(changeset.Dbm)
+}
+
+// Scope returns the changes of one of the CustomSettingScope_* scopes.
+func (changeset *ConfigChangeset) Scope(scope string) []ConfigChange {
+	switch scope {
+	case CustomSettingScope_Registry:
+		return changeset.Registry
+	case CustomSettingScope_Db:
+		return changeset.Db
+	case CustomSettingScope_Dbm:
+		return changeset.Dbm
+	}
+	return nil
+}
+
+// WriteReport writes a human-readable summary of the changeset to "w".
+func (changeset *ConfigChangeset) WriteReport(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Configuration diff: %d changes, %d unchanged\n", changeset.Len(), changeset.Unchanged)
+	if !changeset.HasChanges() {
+		fmt.Fprintf(tw, "No changes. The configuration is up to date.\n")
+		return tw.Flush()
+	}
+	fmt.Fprintf(tw, "\nSCOPE\tSETTING\tCURRENT\tDESIRED\n")
+	for _, scope := range []string{CustomSettingScope_Registry, CustomSettingScope_Db, CustomSettingScope_Dbm} {
+		for _, change := range changeset.Scope(scope) {
+			old := "<unset>"
+			if change.Old != nil {
+				old = *change.Old
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", scope, change.Name, old, change.New)
+		}
+	}
+	return tw.Flush()
+}
+
+// DiffConfiguration returns the settings of "desired" whose value differs from "current", as returned by
+// GetDb2SaasTuneableParam. Settings that "desired" does not set are ignored. Values are compared ignoring
+// surrounding space, and numerically when both are in a range of the rule of the setting (see
+// GetCustomSettingRule), so "100" equals "0100", and "1e2" equals "100" for a setting taking decimal numbers.
+// Keywords are case-sensitive, so "automatic" differs from "AUTOMATIC". A nil "current" reports every desired
+// setting as a change.
+func DiffConfiguration(current *SuccessTuneableParams, desired *PostDb2SaasDbConfigurationOptions) *ConfigChangeset {
+	changeset := &ConfigChangeset{}
+	if desired == nil {
+		return changeset
+	}
+	var params SuccessTuneableParamsTuneableParam
+	if current != nil && current.TuneableParam != nil {
+		params = *current.TuneableParam
+	}
+	changeset.Registry = diffSettings(CustomSettingScope_Registry, params.Registry, desired.Registry, &changeset.Unchanged)
+	changeset.Db = diffSettings(CustomSettingScope_Db, params.Db, desired.Db, &changeset.Unchanged)
+	changeset.Dbm = diffSettings(CustomSettingScope_Dbm, params.Dbm, desired.Dbm, &changeset.Unchanged)
+	return changeset
+}
+
+// diffSettings compares the settings of "desired", a pointer to a CreateCustomSettings* model, with those of
+// "current", a pointer to the matching SuccessTuneableParamsTuneableParam* model, which has the same properties.
+func diffSettings(scope string, current interface{}, desired interface{}, unchanged *int) (changes []ConfigChange) {
+	desiredValue := reflect.ValueOf(desired)
+	if desiredValue.IsNil() {
+		return
+	}
+	desiredValue = desiredValue.Elem()
+	currentValue := reflect.ValueOf(current)
+	for i := 0; i < desiredValue.NumField(); i++ {
+		field := desiredValue.Type().Field(i)
+		newValue, ok := desiredValue.Field(i).Interface().(*string)
+		if !ok || newValue == nil {
+			continue
+		}
+		var oldValue *string
+		if !currentValue.IsNil() {
+			if currentField := currentValue.Elem().FieldByName(field.Name); currentField.IsValid() {
+				oldValue, _ = currentField.Interface().(*string)
+			}
+		}
+		if oldValue != nil && equalSettings(scope, jsonName(field), *oldValue, *newValue) {
+			*unchanged++
+			continue
+		}
+		changes = append(changes, ConfigChange{
+			Name:     jsonName(field),
+			Property: field.Name,
+			Old:      oldValue,
+			New:      *newValue,
+		})
+	}
+	return
+}
+
+// equalSettings returns true if two values of the setting "name" of "scope" are the same, ignoring surrounding
+// space, or the same number of a range of the rule of the setting.
+func equalSettings(scope string, name string, a string, b string) bool {
+	a, b = strings.TrimSpace(a), strings.TrimSpace(b)
+	if a == b {
+		return true
+	}
+	rule, ok := customSettingRules[scope+"."+name]
+	if !ok {
+		return false
+	}
+	for _, r := range rule.Ranges {
+		if r.Contains(a) && r.Contains(b) {
+			x, _ := strconv.ParseFloat(a, 64)
+			y, _ := strconv.ParseFloat(b, 64)
+			return x == y
+		}
+	}
+	return false
+}
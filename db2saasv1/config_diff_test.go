@@ -0,0 +1,145 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1_test
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/IBM/cloud-db2-go-sdk/db2saasfake"
+	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DiffConfiguration`, func() {
+	current := &db2saasv1.SuccessTuneableParams{
+		TuneableParam: &db2saasv1.SuccessTuneableParamsTuneableParam{
+			Db: &db2saasv1.SuccessTuneableParamsTuneableParamDb{
+				UTILHEAPSZ:  core.StringPtr("AUTOMATIC"),
+				LOCKTIMEOUT: core.StringPtr("30"),
+				SORTHEAP:    core.StringPtr("1024"),
+			},
+			Dbm: &db2saasv1.SuccessTuneableParamsTuneableParamDbm{
+				COMMBANDWIDTH: core.StringPtr("100"),
+			},
+			Registry: &db2saasv1.SuccessTuneableParamsTuneableParamRegistry{
+				DB2WORKLOAD: core.StringPtr("ANALYTICS"),
+			},
+		},
+	}
+
+	It(`Diff the settings the caller set`, func() {
+		desired := &db2saasv1.PostDb2SaasDbConfigurationOptions{
+			Db: &db2saasv1.CreateCustomSettingsDb{
+				UTILHEAPSZ:  core.StringPtr("automatic"),
+				LOCKTIMEOUT: core.StringPtr("60"),
+				SORTHEAP:    core.StringPtr(" 01024 "),
+				AUTOMAINT:   core.StringPtr("ON"),
+			},
+			Dbm: &db2saasv1.CreateCustomSettingsDbm{
+				COMMBANDWIDTH: core.StringPtr("100"),
+			},
+			Registry: &db2saasv1.CreateCustomSettingsRegistry{
+				DB2WORKLOAD: core.StringPtr("SAP"),
+			},
+		}
+		changeset := db2saasv1.DiffConfiguration(current, desired)
+		Expect(changeset.HasChanges()).To(BeTrue())
+		Expect(changeset.Len()).To(Equal(4))
+		Expect(changeset.Unchanged).To(Equal(2))
+		Expect(changeset.Db).To(Equal([]db2saasv1.ConfigChange{
+			{Name: "AUTO_MAINT", Property: "AUTOMAINT", Old: nil, New: "ON"},
+			{Name: "LOCKTIMEOUT", Property: "LOCKTIMEOUT", Old: core.StringPtr("30"), New: "60"},
+			{Name: "UTIL_HEAP_SZ", Property: "UTILHEAPSZ", Old: core.StringPtr("AUTOMATIC"), New: "automatic"},
+		}))
+		Expect(changeset.Dbm).To(BeEmpty())
+		Expect(changeset.Scope(db2saasv1.CustomSettingScope_Registry)).To(Equal([]db2saasv1.ConfigChange{
+			{Name: "DB2_WORKLOAD", Property: "DB2WORKLOAD", Old: core.StringPtr("ANALYTICS"), New: "SAP"},
+		}))
+		Expect(changeset.Scope("unknown")).To(BeNil())
+
+		var report bytes.Buffer
+		Expect(changeset.WriteReport(&report)).To(BeNil())
+		Expect(report.String()).To(Equal("Configuration diff: 4 changes, 2 unchanged\n" +
+			"\n" +
+			"SCOPE     SETTING       CURRENT    DESIRED\n" +
+			"registry  DB2_WORKLOAD  ANALYTICS  SAP\n" +
+			"db        AUTO_MAINT    <unset>    ON\n" +
+			"db        LOCKTIMEOUT   30         60\n" +
+			"db        UTIL_HEAP_SZ  AUTOMATIC  automatic\n"))
+	})
+	It(`Diff with nothing to change`, func() {
+		changeset := db2saasv1.DiffConfiguration(current, &db2saasv1.PostDb2SaasDbConfigurationOptions{
+			Dbm: &db2saasv1.CreateCustomSettingsDbm{COMMBANDWIDTH: core.StringPtr("1e2")},
+		})
+		Expect(changeset.HasChanges()).To(BeFalse())
+		Expect(changeset.Unchanged).To(Equal(1))
+
+		var report bytes.Buffer
+		Expect(changeset.WriteReport(&report)).To(BeNil())
+		Expect(report.String()).To(Equal("Configuration diff: 0 changes, 1 unchanged\nNo changes. The configuration is up to date.\n"))
+
+		// Numbers are only compared as numbers within the ranges of the setting.
+		changeset = db2saasv1.DiffConfiguration(current, &db2saasv1.PostDb2SaasDbConfigurationOptions{
+			Db: &db2saasv1.CreateCustomSettingsDb{SORTHEAP: core.StringPtr("1024.0")},
+		})
+		Expect(changeset.Len()).To(Equal(1))
+
+		Expect(db2saasv1.DiffConfiguration(current, nil).HasChanges()).To(BeFalse())
+		Expect(db2saasv1.DiffConfiguration(current, &db2saasv1.PostDb2SaasDbConfigurationOptions{}).Unchanged).To(BeZero())
+	})
+	It(`Diff against no current configuration`, func() {
+		changeset := db2saasv1.DiffConfiguration(nil, &db2saasv1.PostDb2SaasDbConfigurationOptions{
+			Db: &db2saasv1.CreateCustomSettingsDb{LOCKTIMEOUT: core.StringPtr("30")},
+		})
+		Expect(changeset.Db).To(Equal([]db2saasv1.ConfigChange{{Name: "LOCKTIMEOUT", Property: "LOCKTIMEOUT", New: "30"}}))
+	})
+	It(`Diff the configuration of an instance`, func() {
+		fakeServer := db2saasfake.NewServer()
+		defer fakeServer.Close()
+		db2saasService, err := fakeServer.NewService()
+		Expect(err).To(BeNil())
+		fakeServer.SetSetting(fakeCRN, "db", "LOCKTIMEOUT", "30")
+		fakeServer.SetSetting(fakeCRN, "registry", "DB2_WORKLOAD", "ANALYTICS")
+
+		instance, err := db2saasService.Instance(fakeCRN)
+		Expect(err).To(BeNil())
+		changeset, err := instance.Config().Diff(&db2saasv1.PostDb2SaasDbConfigurationOptions{
+			Db:       &db2saasv1.CreateCustomSettingsDb{LOCKTIMEOUT: core.StringPtr("60")},
+			Registry: &db2saasv1.CreateCustomSettingsRegistry{DB2WORKLOAD: core.StringPtr("ANALYTICS")},
+		})
+		Expect(err).To(BeNil())
+		Expect(changeset.Len()).To(Equal(1))
+		Expect(changeset.Unchanged).To(Equal(1))
+		Expect(*changeset.Db[0].Old).To(Equal("30"))
+		Expect(fakeServer.Calls(db2saasfake.RoutePostDbConfiguration)).To(BeZero())
+
+		_, err = instance.Config().Diff(nil)
+		Expect(err).ToNot(BeNil())
+
+		otherProfile := strings.Replace(fakeProfile, "39269573", "00000000", 1)
+		_, err = instance.Config().Diff(&db2saasv1.PostDb2SaasDbConfigurationOptions{
+			XDbProfile: core.StringPtr(otherProfile),
+			Db:         &db2saasv1.CreateCustomSettingsDb{LOCKTIMEOUT: core.StringPtr("60")},
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("the instance handle addresses"))
+		Expect(fakeServer.Calls(db2saasfake.RouteGetTuneableParam)).To(Equal(1))
+	})
+})
@@ -0,0 +1,441 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"text/tabwriter"
+
+	common "github.com/IBM/cloud-db2-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Statuses of an InstanceDrift.
+const (
+	InstanceDrift_Status_InSync  = "in_sync"
+	InstanceDrift_Status_Drifted = "drifted"
+	InstanceDrift_Status_Failed  = "failed"
+	InstanceDrift_Status_Skipped = "skipped"
+)
+
+// ConfigBaseline : The custom settings every instance of a fleet should have. Only the settings that are set are
+// audited. In JSON, a baseline has the shape of the PostDb2SaasDbConfiguration request body.
+type ConfigBaseline struct {
+	Registry *CreateCustomSettingsRegistry `json:"registry,omitempty"`
+
+	Db *CreateCustomSettingsDb `json:"db,omitempty"`
+
+	Dbm *CreateCustomSettingsDbm `json:"dbm,omitempty"`
+}
+
+// options returns the baseline as the options of the update that would apply it, as DiffConfiguration expects.
+func (baseline *ConfigBaseline) options() *PostDb2SaasDbConfigurationOptions {
+	return &PostDb2SaasDbConfigurationOptions{
+		Registry: baseline.Registry,
+		Db:       baseline.Db,
+		Dbm:      baseline.Dbm,
+	}
+}
+
+// ReadConfigBaseline reads a baseline from JSON. Unknown properties, such as misspelled setting names, are
+// rejected. The values are not checked against the domains of the settings: a baseline records the values the
+// instances should have, whatever they are.
+func ReadConfigBaseline(r io.Reader) (baseline *ConfigBaseline, err error) {
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	baseline = &ConfigBaseline{}
+	if err = decoder.Decode(baseline); err != nil {
+		baseline = nil
+		err = core.SDKErrorf(err, "", "config-baseline-read-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// ReadConfigBaselineFile reads a baseline from a JSON file. See ReadConfigBaseline.
+func ReadConfigBaselineFile(path string) (baseline *ConfigBaseline, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "config-baseline-read-error", common.GetComponentInfo())
+		return
+	}
+	defer f.Close()
+	return ReadConfigBaseline(f)
+}
+
+// SettingDrift : A setting of an instance whose value differs from the baseline.
+type SettingDrift struct {
+	// The scope of the setting, one of the CustomSettingScope_* constants.
+	Scope string `json:"scope"`
+
+	// The name of the setting, as sent to the service, such as "UTIL_HEAP_SZ".
+	Name string `json:"name"`
+
+	// The value of the instance, or nil if the service reports none.
+	Actual *string `json:"actual"`
+
+	// The value of the baseline.
+	Expected string `json:"expected"`
+}
+
+// InstanceDrift : The outcome of the audit of one instance.
+type InstanceDrift struct {
+	// The index of the instance in the input.
+	Index int `json:"index"`
+
+	// The CRN of the instance, or the value given if it is not a valid deployment id.
+	Deployment string `json:"deployment"`
+
+	// One of the InstanceDrift_Status_* constants.
+	Status string `json:"status"`
+
+	// The settings that differ from the baseline, if Status is "drifted".
+	Drift []SettingDrift `json:"drift,omitempty"`
+
+	// The number of requests sent for the instance, including retries.
+	Attempts int `json:"attempts"`
+
+	// Why the audit of the instance failed, if Status is "failed".
+	Error error `json:"-"`
+}
+
+// MarshalJSON adds the message of Error as the "error" property.
+func (drift InstanceDrift) MarshalJSON() ([]byte, error) {
+	type instanceDrift InstanceDrift
+	document := struct {
+		instanceDrift
+		Error string `json:"error,omitempty"`
+	}{instanceDrift: instanceDrift(drift)}
+	if drift.Error != nil {
+		document.Error = drift.Error.Error()
+	}
+	return json.Marshal(document)
+}
+
+// ConfigDriftReport : The outcome of AuditConfigDrift.
+type ConfigDriftReport struct {
+	// The instances, in input order.
+	Instances []InstanceDrift `json:"instances"`
+
+	// The number of instances of each status.
+	InSync  int `json:"in_sync"`
+	Drifted int `json:"drifted"`
+	Failed  int `json:"failed"`
+	Skipped int `json:"skipped"`
+}
+
+// HasDrift returns true if some instance differs from the baseline.
+func (report *ConfigDriftReport) HasDrift() bool {
+	return report.Drifted > 0
+}
+
+// WriteJSON writes the report to "w" as indented JSON.
+func (report *ConfigDriftReport) WriteJSON(w io.Writer) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// WriteReport writes a human-readable summary of the report to "w": a table of the settings that differ from
+// the baseline, followed by the instances that could not be audited.
+func (report *ConfigDriftReport) WriteReport(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Configuration drift: %d instances, %d in sync, %d drifted, %d failed, %d skipped\n",
+		len(report.Instances), report.InSync, report.Drifted, report.Failed, report.Skipped)
+	if report.HasDrift() {
+		fmt.Fprintf(tw, "\nINSTANCE\tSCOPE\tSETTING\tACTUAL\tEXPECTED\n")
+		for _, instance := range report.Instances {
+			for _, drift := range instance.Drift {
+				actual := "<unset>"
+				if drift.Actual != nil {
+					actual = *drift.Actual
+				}
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", instance.Deployment, drift.Scope, drift.Name, actual, drift.Expected)
+			}
+		}
+	} else if report.Failed+report.Skipped == 0 {
+		fmt.Fprintf(tw, "No drift. Every instance matches the baseline.\n")
+	}
+	for _, instance := range report.Instances {
+		switch instance.Status {
+		case InstanceDrift_Status_Failed:
+			fmt.Fprintf(tw, "Failed to audit '%s': %s\n", instance.Deployment, instance.Error.Error())
+		case InstanceDrift_Status_Skipped:
+			fmt.Fprintf(tw, "Skipped '%s'\n", instance.Deployment)
+		}
+	}
+	return tw.Flush()
+}
+
+// String returns the report as a table, see WriteReport.
+func (report *ConfigDriftReport) String() string {
+	var buffer bytes.Buffer
+	_ = report.WriteReport(&buffer)
+	return buffer.String()
+}
+
+// ConfigDriftError is returned by AuditConfigDrift when at least one instance could not be audited.
+type ConfigDriftError struct {
+	// The instances that failed, in input order.
+	Failed []*InstanceDrift
+
+	// The number of instances in the input.
+	Total int
+}
+
+func (e *ConfigDriftError) Error() string {
+	first := e.Failed[0]
+	return fmt.Sprintf("%d of %d instances failed; instance '%s': %s", len(e.Failed), e.Total, first.Deployment, first.Error.Error())
+}
+
+// AuditConfigDriftOptions : The AuditConfigDrift options.
+type AuditConfigDriftOptions struct {
+	// The encoded CRN deployment ids of the instances. Raw CRNs are accepted too.
+	XDbProfiles []string `json:"x-db-profiles" validate:"required"`
+
+	// The settings every instance should have.
+	Baseline *ConfigBaseline `json:"baseline" validate:"required"`
+
+	// The maximum number of instances audited at the same time. Defaults to DefaultBulkConcurrency.
+	Concurrency *int64 `json:"concurrency,omitempty"`
+
+	// The maximum number of requests sent per second, across all instances. Zero means no limit.
+	RequestsPerSecond *float64 `json:"requests_per_second,omitempty"`
+
+	// The number of times a request that failed with a transient error is retried. Defaults to
+	// DefaultBulkMaxRetries; a negative value disables retries.
+	MaxRetries *int64 `json:"max_retries,omitempty"`
+
+	// The backoff used between retries of the same request.
+	Backoff *Backoff `json:"-"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewAuditConfigDriftOptions : Instantiate AuditConfigDriftOptions
+func (*Db2saasV1) NewAuditConfigDriftOptions(xDbProfiles []string, baseline *ConfigBaseline) *AuditConfigDriftOptions {
+	return &AuditConfigDriftOptions{
+		XDbProfiles: xDbProfiles,
+		Baseline:    baseline,
+	}
+}
+
+// SetXDbProfiles : Allow user to set XDbProfiles
+func (_options *AuditConfigDriftOptions) SetXDbProfiles(xDbProfiles []string) *AuditConfigDriftOptions {
+	_options.XDbProfiles = xDbProfiles
+	return _options
+}
+
+// SetBaseline : Allow user to set Baseline
+func (_options *AuditConfigDriftOptions) SetBaseline(baseline *ConfigBaseline) *AuditConfigDriftOptions {
+	_options.Baseline = baseline
+	return _options
+}
+
+// SetConcurrency : Allow user to set Concurrency
+func (_options *AuditConfigDriftOptions) SetConcurrency(concurrency int64) *AuditConfigDriftOptions {
+	_options.Concurrency = core.Int64Ptr(concurrency)
+	return _options
+}
+
+// SetRequestsPerSecond : Allow user to set RequestsPerSecond
+func (_options *AuditConfigDriftOptions) SetRequestsPerSecond(requestsPerSecond float64) *AuditConfigDriftOptions {
+	_options.RequestsPerSecond = core.Float64Ptr(requestsPerSecond)
+	return _options
+}
+
+// SetMaxRetries : Allow user to set MaxRetries
+func (_options *AuditConfigDriftOptions) SetMaxRetries(maxRetries int64) *AuditConfigDriftOptions {
+	_options.MaxRetries = core.Int64Ptr(maxRetries)
+	return _options
+}
+
+// SetBackoff : Allow user to set Backoff
+func (_options *AuditConfigDriftOptions) SetBackoff(backoff *Backoff) *AuditConfigDriftOptions {
+	_options.Backoff = backoff
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *AuditConfigDriftOptions) SetHeaders(param map[string]string) *AuditConfigDriftOptions {
+	options.Headers = param
+	return options
+}
+
+// bulkOptions returns the settings of the audit as those of a bulk operation that attempts every instance.
+func (options *AuditConfigDriftOptions) bulkOptions() *BulkOptions {
+	bulkOptions := &BulkOptions{Backoff: options.Backoff, ContinueOnError: true}
+	if options.Concurrency != nil {
+		bulkOptions.Concurrency = int(*options.Concurrency)
+	}
+	if options.RequestsPerSecond != nil {
+		bulkOptions.RequestsPerSecond = *options.RequestsPerSecond
+	}
+	if options.MaxRetries != nil {
+		bulkOptions.MaxRetries = int(*options.MaxRetries)
+	}
+	return bulkOptions
+}
+
+// AuditConfigDrift : Compare the configuration of many instances with a baseline
+func (db2saas *Db2saasV1) AuditConfigDrift(auditConfigDriftOptions *AuditConfigDriftOptions) (report *ConfigDriftReport, err error) {
+	report, err = db2saas.AuditConfigDriftWithContext(context.Background(), auditConfigDriftOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// AuditConfigDriftWithContext is an alternate form of the AuditConfigDrift method which supports a Context parameter.
+// It gets the tuneable parameters of up to Concurrency instances at a time with GetDb2SaasTuneableParam, retrying
+// transient failures, and compares them with the baseline as DiffConfiguration does. Every instance is attempted:
+// instances whose id is invalid, or repeats an earlier one, fail without any request being sent.
+//
+// The report lists every instance in input order, with the settings that differ from the baseline. The error is
+// a *ConfigDriftError if some instances failed, or the context error if "ctx" ended first; the report is returned
+// in both cases.
+func (db2saas *Db2saasV1) AuditConfigDriftWithContext(ctx context.Context, auditConfigDriftOptions *AuditConfigDriftOptions) (report *ConfigDriftReport, err error) {
+	err = core.ValidateNotNil(auditConfigDriftOptions, "auditConfigDriftOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(auditConfigDriftOptions, "auditConfigDriftOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	profiles := auditConfigDriftOptions.XDbProfiles
+	report = &ConfigDriftReport{Instances: make([]InstanceDrift, len(profiles))}
+	deployments := make([]*DeploymentRef, len(profiles))
+	crns := make(map[string]int, len(profiles))
+	for i, profile := range profiles {
+		instance := &report.Instances[i]
+		*instance = InstanceDrift{Index: i, Deployment: profile, Status: InstanceDrift_Status_Skipped}
+		deployment, parseErr := ParseDeploymentRef(profile)
+		if parseErr != nil {
+			instance.Status, instance.Error = InstanceDrift_Status_Failed, parseErr
+			continue
+		}
+		instance.Deployment = deployment.CRN()
+		if first, ok := crns[deployment.CRN()]; ok {
+			instance.Status, instance.Error = InstanceDrift_Status_Failed, fmt.Errorf("instance is also x-db-profiles[%d]", first)
+			continue
+		}
+		crns[deployment.CRN()] = i
+		deployments[i] = deployment
+	}
+
+	db2saas.runAuditConfigDrift(ctx, auditConfigDriftOptions, deployments, report)
+
+	driftErr := &ConfigDriftError{Total: len(profiles)}
+	for i := range report.Instances {
+		switch report.Instances[i].Status {
+		case InstanceDrift_Status_InSync:
+			report.InSync++
+		case InstanceDrift_Status_Drifted:
+			report.Drifted++
+		case InstanceDrift_Status_Failed:
+			report.Failed++
+			driftErr.Failed = append(driftErr.Failed, &report.Instances[i])
+		case InstanceDrift_Status_Skipped:
+			report.Skipped++
+		}
+	}
+	if len(driftErr.Failed) > 0 {
+		err = core.SDKErrorf(driftErr, "", "audit-config-drift-error", common.GetComponentInfo())
+	} else if ctx.Err() != nil {
+		err = core.SDKErrorf(ctx.Err(), "", "audit-config-drift-canceled", common.GetComponentInfo())
+	}
+	return
+}
+
+// runAuditConfigDrift audits the instances whose deployment is set.
+func (db2saas *Db2saasV1) runAuditConfigDrift(ctx context.Context, auditConfigDriftOptions *AuditConfigDriftOptions, deployments []*DeploymentRef, report *ConfigDriftReport) {
+	bulkOptions := auditConfigDriftOptions.bulkOptions()
+	limiter := newRateLimiter(bulkOptions.RequestsPerSecond)
+	desired := auditConfigDriftOptions.Baseline.options()
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for n := bulkOptions.concurrency(len(deployments)); n > 0; n-- {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				retry := &retryRequests{limiter: limiter, retries: bulkOptions.maxRetries(), backoff: bulkOptions.Backoff}
+				db2saas.auditInstance(ctx, deployments[i], auditConfigDriftOptions.Headers, desired, retry, &report.Instances[i])
+				report.Instances[i].Attempts = retry.attempts
+			}
+		}()
+	}
+	for i := range deployments {
+		if deployments[i] == nil {
+			continue
+		}
+		select {
+		case indexes <- i:
+			continue
+		case <-ctx.Done():
+		}
+		break
+	}
+	close(indexes)
+	wg.Wait()
+}
+
+// auditInstance compares the configuration of one instance with "desired" and records the outcome in "instance".
+// An instance left unfinished because the context ended stays "skipped".
+func (db2saas *Db2saasV1) auditInstance(ctx context.Context, deployment *DeploymentRef, headers map[string]string, desired *PostDb2SaasDbConfigurationOptions, retry *retryRequests, instance *InstanceDrift) {
+	options := &GetDb2SaasTuneableParamOptions{Headers: headers}
+	options.SetDeployment(deployment)
+	var current *SuccessTuneableParams
+	_, err := retry.do(ctx, func() (response *core.DetailedResponse, err error) {
+		current, response, err = db2saas.GetDb2SaasTuneableParamWithContext(ctx, options)
+		return
+	})
+	if err != nil {
+		if ctx.Err() == nil {
+			instance.Status, instance.Error = InstanceDrift_Status_Failed, core.RepurposeSDKProblem(err, "get-tuneable-params-error")
+		}
+		return
+	}
+
+	changeset := DiffConfiguration(current, desired)
+	for _, scope := range []string{CustomSettingScope_Registry, CustomSettingScope_Db, CustomSettingScope_Dbm} {
+		for _, change := range changeset.Scope(scope) {
+			instance.Drift = append(instance.Drift, SettingDrift{
+				Scope:    scope,
+				Name:     change.Name,
+				Actual:   change.Old,
+				Expected: change.New,
+			})
+		}
+	}
+	instance.Status = InstanceDrift_Status_InSync
+	if len(instance.Drift) > 0 {
+		instance.Status = InstanceDrift_Status_Drifted
+	}
+}
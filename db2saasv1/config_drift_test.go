@@ -0,0 +1,217 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/IBM/cloud-db2-go-sdk/db2saasfake"
+	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`AuditConfigDrift`, func() {
+	var fakeServer *db2saasfake.Server
+	var db2saasService *db2saasv1.Db2saasV1
+	var crns []string
+	var profiles []string
+	baseline := &db2saasv1.ConfigBaseline{
+		Registry: &db2saasv1.CreateCustomSettingsRegistry{DB2WORKLOAD: core.StringPtr("ANALYTICS")},
+		Db:       &db2saasv1.CreateCustomSettingsDb{LOCKTIMEOUT: core.StringPtr("30"), MAXAPPLS: core.StringPtr("500")},
+	}
+	BeforeEach(func() {
+		var err error
+		fakeServer = db2saasfake.NewServer()
+		db2saasService, err = fakeServer.NewService()
+		Expect(err).To(BeNil())
+
+		crns, profiles = nil, nil
+		for i := 0; i < 12; i++ {
+			crn := strings.Replace(fakeCRN, "39269573", fmt.Sprintf("%08d", i), 1)
+			crns = append(crns, crn)
+			profiles = append(profiles, url.QueryEscape(crn))
+			fakeServer.SetSetting(crn, "registry", "DB2_WORKLOAD", "ANALYTICS")
+			fakeServer.SetSetting(crn, "db", "LOCKTIMEOUT", "30")
+			fakeServer.SetSetting(crn, "db", "MAXAPPLS", "500")
+			fakeServer.SetSetting(crn, "db", "SORTHEAP", fmt.Sprint(1000+i))
+		}
+		fakeServer.SetSetting(crns[3], "db", "LOCKTIMEOUT", "60")
+		fakeServer.SetSetting(crns[3], "registry", "DB2_WORKLOAD", "SAP")
+		fakeServer.SetSetting(crns[7], "db", "MAXAPPLS", "0500")
+		fakeServer.SetSetting(crns[9], "db", "MAXAPPLS", "200")
+	})
+	AfterEach(func() {
+		fakeServer.Close()
+	})
+
+	It(`Invoke AuditConfigDrift successfully`, func() {
+		report, err := db2saasService.AuditConfigDrift(db2saasService.NewAuditConfigDriftOptions(profiles, baseline).SetConcurrency(5))
+		Expect(err).To(BeNil())
+		Expect(report.Instances).To(HaveLen(12))
+		Expect(report.HasDrift()).To(BeTrue())
+		Expect([]int{report.InSync, report.Drifted, report.Failed, report.Skipped}).To(Equal([]int{10, 2, 0, 0}))
+		Expect(fakeServer.Calls(db2saasfake.RouteGetTuneableParam)).To(Equal(12))
+
+		for i, instance := range report.Instances {
+			Expect(instance.Index).To(Equal(i))
+			Expect(instance.Deployment).To(Equal(crns[i]))
+			Expect(instance.Attempts).To(Equal(1))
+		}
+		Expect(report.Instances[3].Status).To(Equal(db2saasv1.InstanceDrift_Status_Drifted))
+		Expect(report.Instances[3].Drift).To(Equal([]db2saasv1.SettingDrift{
+			{Scope: "registry", Name: "DB2_WORKLOAD", Actual: core.StringPtr("SAP"), Expected: "ANALYTICS"},
+			{Scope: "db", Name: "LOCKTIMEOUT", Actual: core.StringPtr("60"), Expected: "30"},
+		}))
+		Expect(report.Instances[7].Status).To(Equal(db2saasv1.InstanceDrift_Status_InSync))
+		Expect(report.Instances[7].Drift).To(BeNil())
+		Expect(report.Instances[9].Drift).To(Equal([]db2saasv1.SettingDrift{
+			{Scope: "db", Name: "MAXAPPLS", Actual: core.StringPtr("200"), Expected: "500"},
+		}))
+
+		var document bytes.Buffer
+		Expect(report.WriteJSON(&document)).To(BeNil())
+		var decoded map[string]interface{}
+		Expect(json.Unmarshal(document.Bytes(), &decoded)).To(BeNil())
+		Expect(decoded["drifted"]).To(BeNumerically("==", 2))
+		instances := decoded["instances"].([]interface{})
+		Expect(instances[0]).To(Equal(map[string]interface{}{
+			"index": 0.0, "deployment": crns[0], "status": "in_sync", "attempts": 1.0,
+		}))
+		Expect(instances[9].(map[string]interface{})["drift"]).To(Equal([]interface{}{
+			map[string]interface{}{"scope": "db", "name": "MAXAPPLS", "actual": "200", "expected": "500"},
+		}))
+
+		table := report.String()
+		Expect(table).To(HavePrefix("Configuration drift: 12 instances, 10 in sync, 2 drifted, 0 failed, 0 skipped\n"))
+		lines := strings.Split(strings.TrimSpace(table), "\n")
+		Expect(lines).To(HaveLen(6))
+		Expect(strings.Fields(lines[2])).To(Equal([]string{"INSTANCE", "SCOPE", "SETTING", "ACTUAL", "EXPECTED"}))
+		Expect(strings.Fields(lines[3])).To(Equal([]string{crns[3], "registry", "DB2_WORKLOAD", "SAP", "ANALYTICS"}))
+		Expect(strings.Fields(lines[4])).To(Equal([]string{crns[3], "db", "LOCKTIMEOUT", "60", "30"}))
+		Expect(strings.Fields(lines[5])).To(Equal([]string{crns[9], "db", "MAXAPPLS", "200", "500"}))
+	})
+	It(`Invoke AuditConfigDrift with no drift`, func() {
+		report, err := db2saasService.AuditConfigDriftWithContext(context.Background(),
+			db2saasService.NewAuditConfigDriftOptions(profiles[:2], &db2saasv1.ConfigBaseline{
+				Db: &db2saasv1.CreateCustomSettingsDb{LOCKTIMEOUT: core.StringPtr("30")},
+			}))
+		Expect(err).To(BeNil())
+		Expect(report.HasDrift()).To(BeFalse())
+		Expect(report.InSync).To(Equal(2))
+		Expect(report.String()).To(HaveSuffix("No drift. Every instance matches the baseline.\n"))
+
+		report, err = db2saasService.AuditConfigDriftWithContext(context.Background(),
+			db2saasService.NewAuditConfigDriftOptions(profiles[:1], &db2saasv1.ConfigBaseline{
+				Dbm: &db2saasv1.CreateCustomSettingsDbm{COMMBANDWIDTH: core.StringPtr("100")},
+			}))
+		Expect(err).To(BeNil())
+		Expect(report.Instances[0].Drift).To(Equal([]db2saasv1.SettingDrift{
+			{Scope: "dbm", Name: "COMM_BANDWIDTH", Expected: "100"},
+		}))
+		Expect(report.String()).To(MatchRegexp(`dbm +COMM_BANDWIDTH +<unset> +100\n`))
+	})
+	It(`Invoke AuditConfigDrift with failed instances`, func() {
+		fakeServer.InjectError(db2saasfake.RouteGetTuneableParam, db2saasfake.Fault{StatusCode: 500, Message: "internal error", Times: 1})
+		auditConfigDriftOptionsModel := db2saasService.NewAuditConfigDriftOptions(
+			[]string{profiles[0], "not-a-crn", profiles[1], crns[1], profiles[3]}, baseline).
+			SetConcurrency(1).
+			SetMaxRetries(-1)
+		report, err := db2saasService.AuditConfigDrift(auditConfigDriftOptionsModel)
+		Expect(err).ToNot(BeNil())
+		Expect(report).ToNot(BeNil())
+		Expect([]int{report.InSync, report.Drifted, report.Failed, report.Skipped}).To(Equal([]int{1, 1, 3, 0}))
+		Expect(fakeServer.Calls(db2saasfake.RouteGetTuneableParam)).To(Equal(3))
+
+		var driftErr *db2saasv1.ConfigDriftError
+		Expect(errors.As(err, &driftErr)).To(BeTrue())
+		Expect(driftErr.Total).To(Equal(5))
+		Expect(driftErr.Failed).To(HaveLen(3))
+		Expect(driftErr.Failed[0].Index).To(Equal(0))
+		Expect(driftErr.Failed[0].Error.Error()).To(ContainSubstring("internal error"))
+		Expect(driftErr.Failed[1].Deployment).To(Equal("not-a-crn"))
+		Expect(driftErr.Failed[2].Deployment).To(Equal(crns[1]))
+		Expect(driftErr.Failed[2].Error.Error()).To(Equal("instance is also x-db-profiles[2]"))
+		Expect(driftErr.Failed[2].Attempts).To(BeZero())
+		Expect(err.Error()).To(HavePrefix(fmt.Sprintf("3 of 5 instances failed; instance '%s'", crns[0])))
+
+		var document bytes.Buffer
+		Expect(report.WriteJSON(&document)).To(BeNil())
+		Expect(document.String()).To(ContainSubstring(`"error": "instance is also x-db-profiles[2]"`))
+		Expect(report.String()).To(ContainSubstring("Failed to audit 'not-a-crn': "))
+
+		fakeServer.InjectError(db2saasfake.RouteGetTuneableParam, db2saasfake.Fault{StatusCode: 503, Message: "unavailable", Times: 2})
+		report, err = db2saasService.AuditConfigDrift(db2saasService.NewAuditConfigDriftOptions(profiles[:1], baseline).SetBackoff(fastBackoff))
+		Expect(err).To(BeNil())
+		Expect(report.Instances[0].Attempts).To(Equal(3))
+	})
+	It(`Invoke AuditConfigDrift with a canceled context`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		report, err := db2saasService.AuditConfigDriftWithContext(ctx, db2saasService.NewAuditConfigDriftOptions(profiles, baseline))
+		Expect(err).ToNot(BeNil())
+		Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+		Expect(report.Skipped).To(Equal(12))
+		Expect(report.String()).To(ContainSubstring(fmt.Sprintf("Skipped '%s'", crns[0])))
+	})
+	It(`Invoke AuditConfigDrift with error: Invalid options`, func() {
+		_, err := db2saasService.AuditConfigDrift(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = db2saasService.AuditConfigDrift(db2saasService.NewAuditConfigDriftOptions(profiles, nil))
+		Expect(err).ToNot(BeNil())
+		Expect(fakeServer.Calls(db2saasfake.RouteGetTuneableParam)).To(BeZero())
+	})
+	It(`Invoke AuditConfigDrift with values outside of the documented domains`, func() {
+		fakeServer.SetSetting(crns[0], "db", "LOCKTIMEOUT", "-1")
+		fakeServer.SetSetting(crns[1], "db", "LOCKTIMEOUT", "forever")
+		report, err := db2saasService.AuditConfigDrift(db2saasService.NewAuditConfigDriftOptions(profiles[:3], &db2saasv1.ConfigBaseline{
+			Db: &db2saasv1.CreateCustomSettingsDb{LOCKTIMEOUT: core.StringPtr("forever")},
+		}))
+		Expect(err).To(BeNil())
+		Expect([]int{report.InSync, report.Drifted}).To(Equal([]int{1, 2}))
+		Expect(report.Instances[0].Drift).To(Equal([]db2saasv1.SettingDrift{
+			{Scope: "db", Name: "LOCKTIMEOUT", Actual: core.StringPtr("-1"), Expected: "forever"},
+		}))
+		Expect(report.Instances[1].Status).To(Equal(db2saasv1.InstanceDrift_Status_InSync))
+	})
+	It(`Read a baseline`, func() {
+		baseline, err := db2saasv1.ReadConfigBaseline(strings.NewReader(`{
+			"registry": {"DB2_WORKLOAD": "ANALYTICS"},
+			"db": {"LOCKTIMEOUT": "30", "MAXAPPLS": "500"}
+		}`))
+		Expect(err).To(BeNil())
+		Expect(*baseline.Registry.DB2WORKLOAD).To(Equal("ANALYTICS"))
+		Expect(*baseline.Db.MAXAPPLS).To(Equal("500"))
+		Expect(baseline.Dbm).To(BeNil())
+
+		_, err = db2saasv1.ReadConfigBaseline(strings.NewReader(`{"db": {"LOCK_TIMEOUT": "30"}}`))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("unknown field"))
+		baseline, err = db2saasv1.ReadConfigBaseline(strings.NewReader(`{"db": {"LOCKTIMEOUT": "-5"}}`))
+		Expect(err).To(BeNil())
+		Expect(*baseline.Db.LOCKTIMEOUT).To(Equal("-5"))
+		_, err = db2saasv1.ReadConfigBaselineFile("testdata/missing.json")
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,332 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"time"
+
+	common "github.com/IBM/cloud-db2-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// States of a ConfigTransaction.
+const (
+	ConfigTransaction_State_Pending    = "pending"
+	ConfigTransaction_State_Applied    = "applied"
+	ConfigTransaction_State_Failed     = "failed"
+	ConfigTransaction_State_RolledBack = "rolled_back"
+)
+
+// ConfigSnapshot : The values the settings touched by a configuration change had before the change. A snapshot
+// can be saved with WriteConfigSnapshotFile and read back with ReadConfigSnapshotFile, so that the change can be
+// rolled back by another process; see ResumeConfigTransaction.
+type ConfigSnapshot struct {
+	// Encoded CRN deployment id.
+	XDbProfile string `json:"x-db-profile"`
+
+	// When the snapshot was taken.
+	CreatedAt time.Time `json:"created_at"`
+
+	// The settings the change modifies, with their previous and new values. Settings the change sets to the
+	// value they already had are only counted.
+	Changes ConfigChangeset `json:"changes"`
+}
+
+// Unrestorable returns the settings, as "scope.NAME", that had no value before the change. The service cannot
+// unset a setting, so a rollback leaves them with the value set by the change.
+func (snapshot *ConfigSnapshot) Unrestorable() (settings []string) {
+	for _, scope := range []string{CustomSettingScope_Registry, CustomSettingScope_Db, CustomSettingScope_Dbm} {
+		for _, change := range snapshot.Changes.Scope(scope) {
+			if change.Old == nil {
+				settings = append(settings, scope+"."+change.Name)
+			}
+		}
+	}
+	return
+}
+
+// Validate checks that the snapshot names a deployment and that every setting it holds exists.
+func (snapshot *ConfigSnapshot) Validate() error {
+	if snapshot.XDbProfile == "" {
+		return fmt.Errorf("the snapshot has no x-db-profile")
+	}
+	for _, scope := range []string{CustomSettingScope_Registry, CustomSettingScope_Db, CustomSettingScope_Dbm} {
+		model := customSettingModels[scope]
+		for _, change := range snapshot.Changes.Scope(scope) {
+			field, ok := model.FieldByName(change.Property)
+			if !ok || jsonName(field) != change.Name {
+				return fmt.Errorf("unknown setting %s.%s (property '%s')", scope, change.Name, change.Property)
+			}
+		}
+	}
+	return nil
+}
+
+// rollbackOptions returns the options of the PostDb2SaasDbConfiguration call that restores the previous values,
+// or nil if no setting had one.
+func (snapshot *ConfigSnapshot) rollbackOptions() *PostDb2SaasDbConfigurationOptions {
+	options := &PostDb2SaasDbConfigurationOptions{XDbProfile: core.StringPtr(snapshot.XDbProfile)}
+	restored := false
+	for _, scope := range []struct {
+		name     string
+		settings interface{}
+	}{
+		{CustomSettingScope_Registry, &options.Registry},
+		{CustomSettingScope_Db, &options.Db},
+		{CustomSettingScope_Dbm, &options.Dbm},
+	} {
+		settings := reflect.ValueOf(scope.settings).Elem()
+		for _, change := range snapshot.Changes.Scope(scope.name) {
+			if change.Old == nil {
+				continue
+			}
+			if settings.IsNil() {
+				settings.Set(reflect.New(customSettingModels[scope.name]))
+			}
+			settings.Elem().FieldByName(change.Property).Set(reflect.ValueOf(core.StringPtr(*change.Old)))
+			restored = true
+		}
+	}
+	if !restored {
+		return nil
+	}
+	return options
+}
+
+// WriteJSON writes the snapshot to "w" as indented JSON.
+func (snapshot *ConfigSnapshot) WriteJSON(w io.Writer) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// ReadConfigSnapshot reads a snapshot written by ConfigSnapshot.WriteJSON. Unknown properties are rejected, and the
+// snapshot is checked with ConfigSnapshot.Validate.
+func ReadConfigSnapshot(r io.Reader) (snapshot *ConfigSnapshot, err error) {
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	snapshot = &ConfigSnapshot{}
+	if err = decoder.Decode(snapshot); err != nil {
+		snapshot = nil
+		err = core.SDKErrorf(err, "", "config-snapshot-read-error", common.GetComponentInfo())
+		return
+	}
+	if err = snapshot.Validate(); err != nil {
+		snapshot = nil
+		err = core.SDKErrorf(err, "", "config-snapshot-validation-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// ReadConfigSnapshotFile reads a snapshot from a JSON file. See ReadConfigSnapshot.
+func ReadConfigSnapshotFile(path string) (snapshot *ConfigSnapshot, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "config-snapshot-read-error", common.GetComponentInfo())
+		return
+	}
+	defer f.Close()
+	return ReadConfigSnapshot(f)
+}
+
+// WriteConfigSnapshotFile writes a snapshot to a JSON file.
+func WriteConfigSnapshotFile(path string, snapshot *ConfigSnapshot) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return core.SDKErrorf(err, "", "config-snapshot-write-error", common.GetComponentInfo())
+	}
+	err = snapshot.WriteJSON(f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "config-snapshot-write-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// ConfigTransaction : A configuration change that can be rolled back.
+//
+// BeginConfigTransaction saves the current values of the settings the change touches in a snapshot, Apply makes
+// the change and Rollback restores the saved values. The snapshot can be written to disk before the change is
+// applied. A ConfigTransaction is not safe for concurrent use.
+type ConfigTransaction struct {
+	service  *Db2saasV1
+	snapshot *ConfigSnapshot
+	options  *PostDb2SaasDbConfigurationOptions
+	state    string
+}
+
+// BeginConfigTransaction : Save the current values of the settings a configuration change touches
+func (db2saas *Db2saasV1) BeginConfigTransaction(postDb2SaasDbConfigurationOptions *PostDb2SaasDbConfigurationOptions) (transaction *ConfigTransaction, err error) {
+	transaction, err = db2saas.BeginConfigTransactionWithContext(context.Background(), postDb2SaasDbConfigurationOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// BeginConfigTransactionWithContext is an alternate form of the BeginConfigTransaction method which supports a
+// Context parameter. It checks the options as PostDb2SaasDbConfiguration does, then gets the current values with
+// GetDb2SaasTuneableParam. The change is not applied until ConfigTransaction.Apply is called. The settings are
+// copied, so changing "postDb2SaasDbConfigurationOptions" afterwards does not change the transaction.
+func (db2saas *Db2saasV1) BeginConfigTransactionWithContext(ctx context.Context, postDb2SaasDbConfigurationOptions *PostDb2SaasDbConfigurationOptions) (transaction *ConfigTransaction, err error) {
+	err = core.ValidateNotNil(postDb2SaasDbConfigurationOptions, "postDb2SaasDbConfigurationOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(postDb2SaasDbConfigurationOptions, "postDb2SaasDbConfigurationOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	err = db2saas.validateSettings(ctx, postDb2SaasDbConfigurationOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "settings-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	current, _, err := db2saas.GetDb2SaasTuneableParamWithContext(ctx, &GetDb2SaasTuneableParamOptions{
+		XDbProfile: postDb2SaasDbConfigurationOptions.XDbProfile,
+		Headers:    postDb2SaasDbConfigurationOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-tuneable-params-error")
+		return
+	}
+	options := *postDb2SaasDbConfigurationOptions
+	if options.Registry != nil {
+		options.Registry = cloneSettings(*options.Registry)
+	}
+	if options.Db != nil {
+		options.Db = cloneSettings(*options.Db)
+	}
+	if options.Dbm != nil {
+		options.Dbm = cloneSettings(*options.Dbm)
+	}
+	transaction = &ConfigTransaction{
+		service: db2saas,
+		snapshot: &ConfigSnapshot{
+			XDbProfile: *options.XDbProfile,
+			CreatedAt:  time.Now().UTC(),
+			Changes:    *DiffConfiguration(current, &options),
+		},
+		options: &options,
+		state:   ConfigTransaction_State_Pending,
+	}
+	return
+}
+
+// ResumeConfigTransaction returns a transaction whose change was made by another process, from the snapshot that
+// process saved. The transaction is in the "applied" state: it can only be rolled back.
+func (db2saas *Db2saasV1) ResumeConfigTransaction(snapshot *ConfigSnapshot) (transaction *ConfigTransaction, err error) {
+	err = core.ValidateNotNil(snapshot, "snapshot cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = snapshot.Validate()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "config-snapshot-validation-error", common.GetComponentInfo())
+		return
+	}
+	transaction = &ConfigTransaction{
+		service:  db2saas,
+		snapshot: snapshot,
+		state:    ConfigTransaction_State_Applied,
+	}
+	return
+}
+
+// Snapshot returns the values the touched settings had when the transaction began.
+func (transaction *ConfigTransaction) Snapshot() *ConfigSnapshot {
+	return transaction.snapshot
+}
+
+// State returns one of the ConfigTransaction_State_* constants.
+func (transaction *ConfigTransaction) State() string {
+	return transaction.state
+}
+
+// Apply : Apply the configuration change
+//
+// Apply can only be called on a pending transaction. If it fails, the transaction is "failed" and can still be
+// rolled back, since the service may have applied part of the change.
+func (transaction *ConfigTransaction) Apply() (result *SuccessPostCustomSettings, response *core.DetailedResponse, err error) {
+	result, response, err = transaction.ApplyWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ApplyWithContext is an alternate form of the Apply method which supports a Context parameter.
+func (transaction *ConfigTransaction) ApplyWithContext(ctx context.Context) (result *SuccessPostCustomSettings, response *core.DetailedResponse, err error) {
+	if transaction.state != ConfigTransaction_State_Pending {
+		err = fmt.Errorf("cannot apply a transaction that is %s", transaction.state)
+		err = core.SDKErrorf(err, "", "transaction-state-error", common.GetComponentInfo())
+		return
+	}
+	result, response, err = transaction.service.PostDb2SaasDbConfigurationWithContext(ctx, transaction.options)
+	if err != nil {
+		transaction.state = ConfigTransaction_State_Failed
+		err = core.RepurposeSDKProblem(err, "apply-configuration-error")
+		return
+	}
+	transaction.state = ConfigTransaction_State_Applied
+	return
+}
+
+// Rollback : Restore the values the touched settings had when the transaction began
+//
+// Rollback can only be called on an applied or failed transaction. The previous values are sent as they were
+// read, without checking them against the domains of the settings: the service may hold values outside of the
+// documented domains. Settings that had no value are left as they are, see ConfigSnapshot.Unrestorable. No
+// request is sent if no setting had a value. A transaction can be rolled back once; if the rollback fails, it can
+// be retried.
+func (transaction *ConfigTransaction) Rollback() (response *core.DetailedResponse, err error) {
+	response, err = transaction.RollbackWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// RollbackWithContext is an alternate form of the Rollback method which supports a Context parameter.
+func (transaction *ConfigTransaction) RollbackWithContext(ctx context.Context) (response *core.DetailedResponse, err error) {
+	if transaction.state != ConfigTransaction_State_Applied && transaction.state != ConfigTransaction_State_Failed {
+		err = fmt.Errorf("cannot roll back a transaction that is %s", transaction.state)
+		err = core.SDKErrorf(err, "", "transaction-state-error", common.GetComponentInfo())
+		return
+	}
+	if options := transaction.snapshot.rollbackOptions(); options != nil {
+		if transaction.options != nil {
+			options.Headers = transaction.options.Headers
+		}
+		_, response, err = transaction.service.PostDb2SaasDbConfigurationWithContext(withoutSettingsValidation(ctx), options)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "rollback-configuration-error")
+			return
+		}
+	}
+	transaction.state = ConfigTransaction_State_RolledBack
+	return
+}
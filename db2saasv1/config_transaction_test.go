@@ -0,0 +1,252 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IBM/cloud-db2-go-sdk/db2saasfake"
+	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ConfigTransaction`, func() {
+	var fakeServer *db2saasfake.Server
+	var db2saasService *db2saasv1.Db2saasV1
+	setting := func(scope string, name string) string {
+		value, ok := fakeServer.Setting(fakeCRN, scope, name)
+		if !ok {
+			return "<unset>"
+		}
+		return value
+	}
+	newOptions := func() *db2saasv1.PostDb2SaasDbConfigurationOptions {
+		return db2saasService.NewPostDb2SaasDbConfigurationOptions(fakeProfile).
+			SetRegistry(&db2saasv1.CreateCustomSettingsRegistry{DB2WORKLOAD: core.StringPtr("SAP")}).
+			SetDb(&db2saasv1.CreateCustomSettingsDb{LOCKTIMEOUT: core.StringPtr("60"), SORTHEAP: core.StringPtr("2000")}).
+			SetDbm(&db2saasv1.CreateCustomSettingsDbm{COMMBANDWIDTH: core.StringPtr("100")})
+	}
+	BeforeEach(func() {
+		var err error
+		fakeServer = db2saasfake.NewServer()
+		db2saasService, err = fakeServer.NewService()
+		Expect(err).To(BeNil())
+
+		fakeServer.SetSetting(fakeCRN, "registry", "DB2_WORKLOAD", "ANALYTICS")
+		fakeServer.SetSetting(fakeCRN, "db", "LOCKTIMEOUT", "30")
+		fakeServer.SetSetting(fakeCRN, "db", "SORTHEAP", "2000")
+	})
+	AfterEach(func() {
+		fakeServer.Close()
+	})
+
+	It(`Apply and roll back a configuration change`, func() {
+		transaction, err := db2saasService.BeginConfigTransaction(newOptions())
+		Expect(err).To(BeNil())
+		Expect(transaction.State()).To(Equal(db2saasv1.ConfigTransaction_State_Pending))
+		Expect(fakeServer.Calls(db2saasfake.RoutePostDbConfiguration)).To(BeZero())
+
+		snapshot := transaction.Snapshot()
+		Expect(snapshot.XDbProfile).To(Equal(fakeProfile))
+		Expect(snapshot.CreatedAt).ToNot(BeZero())
+		Expect(snapshot.Changes.Registry).To(Equal([]db2saasv1.ConfigChange{
+			{Name: "DB2_WORKLOAD", Property: "DB2WORKLOAD", Old: core.StringPtr("ANALYTICS"), New: "SAP"},
+		}))
+		Expect(snapshot.Changes.Db).To(Equal([]db2saasv1.ConfigChange{
+			{Name: "LOCKTIMEOUT", Property: "LOCKTIMEOUT", Old: core.StringPtr("30"), New: "60"},
+		}))
+		Expect(snapshot.Changes.Unchanged).To(Equal(1))
+		Expect(snapshot.Unrestorable()).To(Equal([]string{"dbm.COMM_BANDWIDTH"}))
+
+		result, response, err := transaction.Apply()
+		Expect(err).To(BeNil())
+		Expect(response).ToNot(BeNil())
+		Expect(*result.Status).To(Equal("success"))
+		Expect(transaction.State()).To(Equal(db2saasv1.ConfigTransaction_State_Applied))
+		Expect([]string{setting("registry", "DB2_WORKLOAD"), setting("db", "LOCKTIMEOUT"), setting("dbm", "COMM_BANDWIDTH")}).
+			To(Equal([]string{"SAP", "60", "100"}))
+
+		_, _, err = transaction.Apply()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("cannot apply a transaction that is applied"))
+
+		_, err = transaction.Rollback()
+		Expect(err).To(BeNil())
+		Expect(transaction.State()).To(Equal(db2saasv1.ConfigTransaction_State_RolledBack))
+		Expect([]string{setting("registry", "DB2_WORKLOAD"), setting("db", "LOCKTIMEOUT"), setting("db", "SORTHEAP"), setting("dbm", "COMM_BANDWIDTH")}).
+			To(Equal([]string{"ANALYTICS", "30", "2000", "100"}))
+		Expect(fakeServer.Calls(db2saasfake.RoutePostDbConfiguration)).To(Equal(2))
+
+		_, err = transaction.Rollback()
+		Expect(err).ToNot(BeNil())
+		Expect(fakeServer.Calls(db2saasfake.RoutePostDbConfiguration)).To(Equal(2))
+	})
+	It(`Apply the settings as they were when the transaction began`, func() {
+		options := newOptions()
+		transaction, err := db2saasService.BeginConfigTransaction(options)
+		Expect(err).To(BeNil())
+		options.Db.LOCKTIMEOUT = core.StringPtr("90")
+		*options.Registry.DB2WORKLOAD = "OLTP"
+
+		_, _, err = transaction.Apply()
+		Expect(err).To(BeNil())
+		Expect([]string{setting("registry", "DB2_WORKLOAD"), setting("db", "LOCKTIMEOUT")}).To(Equal([]string{"SAP", "60"}))
+	})
+	It(`Roll back a change that failed`, func() {
+		instance, err := db2saasService.Instance(fakeCRN)
+		Expect(err).To(BeNil())
+		transaction, err := instance.Config().Begin(&db2saasv1.PostDb2SaasDbConfigurationOptions{
+			Db: &db2saasv1.CreateCustomSettingsDb{LOCKTIMEOUT: core.StringPtr("60")},
+		})
+		Expect(err).To(BeNil())
+
+		fakeServer.InjectError(db2saasfake.RoutePostDbConfiguration, db2saasfake.Fault{StatusCode: 500, Message: "internal error", Times: 1})
+		_, _, err = transaction.Apply()
+		Expect(err).ToNot(BeNil())
+		Expect(transaction.State()).To(Equal(db2saasv1.ConfigTransaction_State_Failed))
+
+		fakeServer.InjectError(db2saasfake.RoutePostDbConfiguration, db2saasfake.Fault{StatusCode: 500, Message: "internal error", Times: 1})
+		_, err = transaction.Rollback()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("internal error"))
+		Expect(transaction.State()).To(Equal(db2saasv1.ConfigTransaction_State_Failed))
+
+		_, err = transaction.Rollback()
+		Expect(err).To(BeNil())
+		Expect(setting("db", "LOCKTIMEOUT")).To(Equal("30"))
+	})
+	It(`Roll back to values outside of the documented domains`, func() {
+		fakeServer.SetSetting(fakeCRN, "db", "LOCKTIMEOUT", "-1")
+		fakeServer.SetSetting(fakeCRN, "db", "SORTHEAP", "-1")
+		transaction, err := db2saasService.BeginConfigTransaction(db2saasService.NewPostDb2SaasDbConfigurationOptions(fakeProfile).
+			SetDb(&db2saasv1.CreateCustomSettingsDb{LOCKTIMEOUT: core.StringPtr("60"), SORTHEAP: core.StringPtr("2000")}))
+		Expect(err).To(BeNil())
+		_, _, err = transaction.Apply()
+		Expect(err).To(BeNil())
+		Expect([]string{setting("db", "LOCKTIMEOUT"), setting("db", "SORTHEAP")}).To(Equal([]string{"60", "2000"}))
+
+		_, err = transaction.Rollback()
+		Expect(err).To(BeNil())
+		Expect(transaction.State()).To(Equal(db2saasv1.ConfigTransaction_State_RolledBack))
+		Expect([]string{setting("db", "LOCKTIMEOUT"), setting("db", "SORTHEAP")}).To(Equal([]string{"-1", "-1"}))
+		Expect(db2saasService.GetValidateCustomSettings()).To(BeTrue())
+	})
+	It(`Invoke Rollback with error: Pending transaction`, func() {
+		transaction, err := db2saasService.BeginConfigTransaction(newOptions())
+		Expect(err).To(BeNil())
+		_, err = transaction.Rollback()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("cannot roll back a transaction that is pending"))
+		Expect(transaction.State()).To(Equal(db2saasv1.ConfigTransaction_State_Pending))
+		Expect(fakeServer.Calls(db2saasfake.RoutePostDbConfiguration)).To(BeZero())
+
+		_, _, err = transaction.Apply()
+		Expect(err).To(BeNil())
+	})
+	It(`Roll back a change without previous values`, func() {
+		transaction, err := db2saasService.BeginConfigTransaction(db2saasService.NewPostDb2SaasDbConfigurationOptions(fakeProfile).
+			SetDbm(&db2saasv1.CreateCustomSettingsDbm{COMMBANDWIDTH: core.StringPtr("100")}))
+		Expect(err).To(BeNil())
+		_, _, err = transaction.Apply()
+		Expect(err).To(BeNil())
+		response, err := transaction.Rollback()
+		Expect(err).To(BeNil())
+		Expect(response).To(BeNil())
+		Expect(transaction.State()).To(Equal(db2saasv1.ConfigTransaction_State_RolledBack))
+		Expect(fakeServer.Calls(db2saasfake.RoutePostDbConfiguration)).To(Equal(1))
+	})
+	It(`Save a snapshot and roll back from another process`, func() {
+		dir, err := os.MkdirTemp("", "config-snapshot")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "snapshot.json")
+
+		transaction, err := db2saasService.BeginConfigTransaction(newOptions())
+		Expect(err).To(BeNil())
+		Expect(db2saasv1.WriteConfigSnapshotFile(path, transaction.Snapshot())).To(BeNil())
+		_, _, err = transaction.Apply()
+		Expect(err).To(BeNil())
+
+		snapshot, err := db2saasv1.ReadConfigSnapshotFile(path)
+		Expect(err).To(BeNil())
+		Expect(snapshot.CreatedAt.Equal(transaction.Snapshot().CreatedAt)).To(BeTrue())
+		snapshot.CreatedAt = transaction.Snapshot().CreatedAt
+		Expect(snapshot).To(Equal(transaction.Snapshot()))
+
+		resumed, err := db2saasService.ResumeConfigTransaction(snapshot)
+		Expect(err).To(BeNil())
+		Expect(resumed.State()).To(Equal(db2saasv1.ConfigTransaction_State_Applied))
+		_, _, err = resumed.ApplyWithContext(context.Background())
+		Expect(err).ToNot(BeNil())
+		_, err = resumed.RollbackWithContext(context.Background())
+		Expect(err).To(BeNil())
+		Expect(setting("registry", "DB2_WORKLOAD")).To(Equal("ANALYTICS"))
+		Expect(setting("db", "LOCKTIMEOUT")).To(Equal("30"))
+	})
+	It(`Read a snapshot`, func() {
+		var document bytes.Buffer
+		Expect((&db2saasv1.ConfigSnapshot{
+			XDbProfile: fakeProfile,
+			Changes: db2saasv1.ConfigChangeset{
+				Db: []db2saasv1.ConfigChange{{Name: "LOCKTIMEOUT", Property: "LOCKTIMEOUT", Old: core.StringPtr("30"), New: "60"}},
+			},
+		}).WriteJSON(&document)).To(BeNil())
+		Expect(document.String()).To(ContainSubstring(`"old": "30"`))
+		_, err := db2saasv1.ReadConfigSnapshot(&document)
+		Expect(err).To(BeNil())
+
+		for _, test := range []struct {
+			document string
+			message  string
+		}{
+			{`{"changes": {}}`, "the snapshot has no x-db-profile"},
+			{`{"x-db-profile": "p", "changes": {"db": [{"name": "LOCK_TIMEOUT", "property": "LOCKTIMEOUT", "new": "1"}]}}`, "unknown setting db.LOCK_TIMEOUT"},
+			{`{"x-db-profile": "p", "changes": {"dbm": [{"name": "UTIL_HEAP_SZ", "property": "UTILHEAPSZ", "new": "1"}]}}`, "unknown setting dbm.UTIL_HEAP_SZ"},
+			{`{"x-db-profile": "p", "extra": true}`, "unknown field"},
+			{`[]`, "cannot unmarshal"},
+		} {
+			_, err = db2saasv1.ReadConfigSnapshot(strings.NewReader(test.document))
+			Expect(err).ToNot(BeNil(), test.document)
+			Expect(err.Error()).To(ContainSubstring(test.message))
+		}
+		_, err = db2saasService.ResumeConfigTransaction(&db2saasv1.ConfigSnapshot{})
+		Expect(err).ToNot(BeNil())
+		_, err = db2saasService.ResumeConfigTransaction(nil)
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Invoke BeginConfigTransaction with error`, func() {
+		_, err := db2saasService.BeginConfigTransaction(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = db2saasService.BeginConfigTransaction(&db2saasv1.PostDb2SaasDbConfigurationOptions{})
+		Expect(err).ToNot(BeNil())
+		_, err = db2saasService.BeginConfigTransaction(db2saasService.NewPostDb2SaasDbConfigurationOptions(fakeProfile).
+			SetDb(&db2saasv1.CreateCustomSettingsDb{LOCKTIMEOUT: core.StringPtr("forever")}))
+		Expect(err).ToNot(BeNil())
+		Expect(fakeServer.Calls(db2saasfake.RouteGetTuneableParam)).To(BeZero())
+
+		fakeServer.InjectError(db2saasfake.RouteGetTuneableParam, db2saasfake.Fault{StatusCode: 500, Message: "internal error", Times: 1})
+		_, err = db2saasService.BeginConfigTransaction(newOptions())
+		Expect(err).ToNot(BeNil())
+		Expect(fakeServer.Calls(db2saasfake.RoutePostDbConfiguration)).To(BeZero())
+	})
+})
@@ -0,0 +1,513 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Scopes of the custom settings, named after the properties of PostDb2SaasDbConfigurationOptions.
+const (
+	CustomSettingScope_Db       = "db"
+	CustomSettingScope_Dbm      = "dbm"
+	CustomSettingScope_Registry = "registry"
+)
+
+// CustomSettingRange : A range of numeric values allowed for a custom setting, bounds included.
+type CustomSettingRange struct {
+	Min float64
+
+	Max float64
+
+	// Whether the values must be integers.
+	Integer bool
+}
+
+// Contains returns true if "value" is a number in the range.
+func (r CustomSettingRange) Contains(value string) bool {
+	var number float64
+	if r.Integer {
+		i, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return false
+		}
+		number = float64(i)
+	} else {
+		f, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return false
+		}
+		number = f
+	}
+	return number >= r.Min && number <= r.Max
+}
+
+// String describes the range, e.g. "an integer from 16 to 2147483647".
+func (r CustomSettingRange) String() string {
+	kind := "a number"
+	if r.Integer {
+		kind = "an integer"
+	}
+	return fmt.Sprintf("%s from %s to %s", kind, strconv.FormatFloat(r.Min, 'f', -1, 64), strconv.FormatFloat(r.Max, 'f', -1, 64))
+}
+
+// CustomSettingRule : The values allowed for a custom setting, built from the constants associated with its
+// property in CreateCustomSettingsDb, CreateCustomSettingsDbm or CreateCustomSettingsRegistry.
+type CustomSettingRule struct {
+	// The scope of the setting, one of the CustomSettingScope_* constants.
+	Scope string
+
+	// The name of the setting, as sent to the service, such as "UTIL_HEAP_SZ".
+	Name string
+
+	// The name of the property of the settings model, such as "UTILHEAPSZ".
+	Property string
+
+	// The keywords allowed, such as "AUTOMATIC". Keywords are case-sensitive.
+	Keywords []string
+
+	// The numeric ranges allowed.
+	Ranges []CustomSettingRange
+}
+
+// Allows returns true if "value" is one of the keywords of the rule or a number in one of its ranges.
+func (rule *CustomSettingRule) Allows(value string) bool {
+	if containsString(rule.Keywords, value) {
+		return true
+	}
+	for _, r := range rule.Ranges {
+		if r.Contains(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Domain describes the values allowed by the rule, e.g. "'AUTOMATIC' or an integer from 16 to 2147483647".
+func (rule *CustomSettingRule) Domain() string {
+	var alternatives []string
+	for _, keyword := range rule.Keywords {
+		alternatives = append(alternatives, "'"+keyword+"'")
+	}
+	for _, r := range rule.Ranges {
+		alternatives = append(alternatives, r.String())
+	}
+	if len(alternatives) < 2 {
+		return strings.Join(alternatives, "")
+	}
+	return strings.Join(alternatives[:len(alternatives)-1], ", ") + " or " + alternatives[len(alternatives)-1]
+}
+
+// CustomSettingRules returns the rules of every custom setting with documented values, sorted by scope and name.
+// Settings without associated constants, such as DB2_COMPATIBILITY_VECTOR, accept any value and have no rule.
+func CustomSettingRules() []CustomSettingRule {
+	rules := make([]CustomSettingRule, 0, len(customSettingRules))
+	for _, rule := range customSettingRules {
+		rules = append(rules, *rule)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].Scope != rules[j].Scope {
+			return rules[i].Scope < rules[j].Scope
+		}
+		return rules[i].Name < rules[j].Name
+	})
+	return rules
+}
+
+// GetCustomSettingRule returns the rule of the setting "name" of "scope". The name may be the name sent to the
+// service, such as "UTIL_HEAP_SZ", or the name of the model property, such as "UTILHEAPSZ".
+func GetCustomSettingRule(scope string, name string) (rule CustomSettingRule, ok bool) {
+	if r, found := customSettingRules[scope+"."+name]; found {
+		return *r, true
+	}
+	for _, r := range customSettingRules {
+		if r.Scope == scope && r.Property == name {
+			return *r, true
+		}
+	}
+	return
+}
+
+// CustomSettingViolation : A custom setting whose value is outside of its documented domain.
+type CustomSettingViolation struct {
+	// The scope of the setting, one of the CustomSettingScope_* constants.
+	Scope string
+
+	// The name of the setting, as sent to the service.
+	Name string
+
+	// The rejected value.
+	Value string
+
+	// The values allowed, see CustomSettingRule.Domain.
+	Domain string
+}
+
+// CustomSettingsError : The error returned when custom settings have values outside of their documented domain.
+type CustomSettingsError struct {
+	Violations []CustomSettingViolation
+}
+
+// Error lists every violation.
+func (e *CustomSettingsError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		messages[i] = fmt.Sprintf("%s.%s: invalid value '%s', must be %s", v.Scope, v.Name, v.Value, v.Domain)
+	}
+	return fmt.Sprintf("%d invalid custom setting(s): %s", len(e.Violations), strings.Join(messages, "; "))
+}
+
+// Validate returns a CustomSettingsError listing the settings whose value is outside of their documented domain.
+func (settings *CreateCustomSettingsDb) Validate() error {
+	return validateCustomSettings(CustomSettingScope_Db, settings)
+}
+
+// Validate returns a CustomSettingsError listing the settings whose value is outside of their documented domain.
+func (settings *CreateCustomSettingsDbm) Validate() error {
+	return validateCustomSettings(CustomSettingScope_Dbm, settings)
+}
+
+// Validate returns a CustomSettingsError listing the settings whose value is outside of their documented domain.
+func (settings *CreateCustomSettingsRegistry) Validate() error {
+	return validateCustomSettings(CustomSettingScope_Registry, settings)
+}
+
+// ValidateSettings returns a CustomSettingsError listing the settings of every scope whose value is outside of
+// their documented domain. PostDb2SaasDbConfiguration calls it before sending a request, unless the check is
+// turned off with SetValidateCustomSettings.
+func (options *PostDb2SaasDbConfigurationOptions) ValidateSettings() error {
+	var violations []CustomSettingViolation
+	for _, scope := range []struct {
+		name     string
+		settings interface{}
+	}{
+		{CustomSettingScope_Registry, options.Registry},
+		{CustomSettingScope_Db, options.Db},
+		{CustomSettingScope_Dbm, options.Dbm},
+	} {
+		violations = append(violations, customSettingViolations(scope.name, scope.settings)...)
+	}
+	if len(violations) > 0 {
+		return &CustomSettingsError{Violations: violations}
+	}
+	return nil
+}
+
+// SetValidateCustomSettings sets whether PostDb2SaasDbConfiguration checks the custom settings with
+// ValidateSettings before sending a request. The check is on by default; turn it off to send values that the
+// rules do not describe and leave them to the service.
+func (db2saas *Db2saasV1) SetValidateCustomSettings(validate bool) {
+	db2saas.skipSettingsValidation = !validate
+}
+
+// GetValidateCustomSettings returns true if PostDb2SaasDbConfiguration checks the custom settings.
+func (db2saas *Db2saasV1) GetValidateCustomSettings() bool {
+	return !db2saas.skipSettingsValidation
+}
+
+// skipSettingsValidationKey marks the contexts of requests made by helpers that send settings read from the
+// service, such as ConfigTransaction.Rollback, which restores them as they were.
+type skipSettingsValidationKey struct{}
+
+// withoutSettingsValidation returns a context whose configuration requests are sent without checking their settings.
+func withoutSettingsValidation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipSettingsValidationKey{}, true)
+}
+
+// validateSettings checks the settings of a configuration request sent with "ctx", unless the check is off.
+func (db2saas *Db2saasV1) validateSettings(ctx context.Context, options *PostDb2SaasDbConfigurationOptions) error {
+	if db2saas.skipSettingsValidation || ctx.Value(skipSettingsValidationKey{}) != nil {
+		return nil
+	}
+	return options.ValidateSettings()
+}
+
+func validateCustomSettings(scope string, settings interface{}) error {
+	if violations := customSettingViolations(scope, settings); len(violations) > 0 {
+		return &CustomSettingsError{Violations: violations}
+	}
+	return nil
+}
+
+// customSettingViolations checks every property of "settings", a pointer to a custom settings model, that is set.
+func customSettingViolations(scope string, settings interface{}) (violations []CustomSettingViolation) {
+	value := reflect.ValueOf(settings)
+	if value.Kind() != reflect.Pointer || value.IsNil() {
+		return
+	}
+	value = value.Elem()
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+		if field.Kind() != reflect.Pointer || field.IsNil() || field.Elem().Kind() != reflect.String {
+			continue
+		}
+		rule, ok := customSettingRules[scope+"."+jsonName(value.Type().Field(i))]
+		if !ok {
+			continue
+		}
+		if setting := field.Elem().String(); !rule.Allows(setting) {
+			violations = append(violations, CustomSettingViolation{
+				Scope:  scope,
+				Name:   rule.Name,
+				Value:  setting,
+				Domain: rule.Domain(),
+			})
+		}
+	}
+	return
+}
+
+// jsonName returns the name of the property of a struct field in JSON.
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// customSettingModels are the models of each scope of custom settings.
+var customSettingModels = map[string]reflect.Type{
+	CustomSettingScope_Db:       reflect.TypeOf(CreateCustomSettingsDb{}),
+	CustomSettingScope_Dbm:      reflect.TypeOf(CreateCustomSettingsDbm{}),
+	CustomSettingScope_Registry: reflect.TypeOf(CreateCustomSettingsRegistry{}),
+}
+
+var customSettingRangePattern = regexp.MustCompile(`^range\(\s*([-0-9.]+)\s*,\s*([-0-9.]+)\s*\)$`)
+
+// customSettingRules holds the rules of the custom settings, by scope and name, e.g. "db.UTIL_HEAP_SZ".
+var customSettingRules = buildCustomSettingRules()
+
+// buildCustomSettingRules turns customSettingDomains into rules. Constants of the form "range(min, max)" are
+// ranges, integer ones unless a bound has a decimal point, and the other constants are keywords, as are the
+// values of customSettingSpecialValues.
+func buildCustomSettingRules() map[string]*CustomSettingRule {
+	rules := map[string]*CustomSettingRule{}
+	for scope, properties := range customSettingDomains {
+		model := customSettingModels[scope]
+		for property, domain := range properties {
+			field, ok := model.FieldByName(property)
+			if !ok {
+				panic(fmt.Sprintf("db2saasv1: no property %s in %s", property, model.Name()))
+			}
+			rule := &CustomSettingRule{Scope: scope, Name: jsonName(field), Property: property}
+			for _, value := range domain {
+				match := customSettingRangePattern.FindStringSubmatch(value)
+				if match == nil {
+					rule.Keywords = append(rule.Keywords, value)
+					continue
+				}
+				lo, loErr := strconv.ParseFloat(match[1], 64)
+				hi, hiErr := strconv.ParseFloat(match[2], 64)
+				if loErr != nil || hiErr != nil {
+					panic(fmt.Sprintf("db2saasv1: invalid range %q of %s.%s", value, model.Name(), property))
+				}
+				rule.Ranges = append(rule.Ranges, CustomSettingRange{
+					Min:     lo,
+					Max:     hi,
+					Integer: !strings.Contains(match[1]+match[2], "."),
+				})
+			}
+			rule.Keywords = append(rule.Keywords, customSettingSpecialValues[scope][property]...)
+			rules[scope+"."+rule.Name] = rule
+		}
+	}
+	return rules
+}
+
+// customSettingSpecialValues maps the properties of the custom settings models to the Db2 values that their
+// constants leave out, such as -1 for LOCKTIMEOUT, which waits for locks indefinitely, and that the service
+// reports for instances using the Db2 defaults. It lists every value of the domains returned by
+// GetDb2SaasTuneableParam that the constants do not allow.
+var customSettingSpecialValues = map[string]map[string][]string{
+	CustomSettingScope_Registry: {
+		"DB2WORKLOAD": {"1C"},
+	},
+	CustomSettingScope_Db: {
+		"DFTDEGREE":   {"-1"},
+		"LOCKTIMEOUT": {"-1"},
+		"LOGDISKCAP":  {"0", "-1"},
+		"PCKCACHESZ":  {"-1"},
+	},
+	CustomSettingScope_Dbm: {
+		"COMMBANDWIDTH":  {"-1"},
+		"CPUSPEED":       {"-1"},
+		"FEDERATEDASYNC": {"-1"},
+		"MAXQUERYDEGREE": {"-1"},
+		"NUMPOOLAGENTS":  {"-1"},
+	},
+}
+
+// customSettingDomains maps the properties of the custom settings models to the constants associated with them.
+var customSettingDomains = map[string]map[string][]string{
+	CustomSettingScope_Registry: {
+		"DB2BIDI":                      {CreateCustomSettingsRegistry_DB2BIDI_No, CreateCustomSettingsRegistry_DB2BIDI_Yes},
+		"DB2LOCKTORB":                  {CreateCustomSettingsRegistry_DB2LOCKTORB_Statement},
+		"DB2STMM":                      {CreateCustomSettingsRegistry_DB2STMM_No, CreateCustomSettingsRegistry_DB2STMM_Yes},
+		"DB2ALTERNATEAUTHZBEHAVIOUR":   {CreateCustomSettingsRegistry_DB2ALTERNATEAUTHZBEHAVIOUR_ExternalRoutineDbadm, CreateCustomSettingsRegistry_DB2ALTERNATEAUTHZBEHAVIOUR_ExternalRoutineDbauth},
+		"DB2ANTIJOIN":                  {CreateCustomSettingsRegistry_DB2ANTIJOIN_Extend, CreateCustomSettingsRegistry_DB2ANTIJOIN_No, CreateCustomSettingsRegistry_DB2ANTIJOIN_Yes},
+		"DB2ATSENABLE":                 {CreateCustomSettingsRegistry_DB2ATSENABLE_No, CreateCustomSettingsRegistry_DB2ATSENABLE_Yes},
+		"DB2DEFERREDPREPARESEMANTICS":  {CreateCustomSettingsRegistry_DB2DEFERREDPREPARESEMANTICS_No, CreateCustomSettingsRegistry_DB2DEFERREDPREPARESEMANTICS_Yes},
+		"DB2EVALUNCOMMITTED":           {CreateCustomSettingsRegistry_DB2EVALUNCOMMITTED_No, CreateCustomSettingsRegistry_DB2EVALUNCOMMITTED_Yes},
+		"DB2INDEXPCTFREEDEFAULT":       {CreateCustomSettingsRegistry_DB2INDEXPCTFREEDEFAULT_Range099},
+		"DB2INLISTTONLJN":              {CreateCustomSettingsRegistry_DB2INLISTTONLJN_No, CreateCustomSettingsRegistry_DB2INLISTTONLJN_Yes},
+		"DB2MINIMIZELISTPREFETCH":      {CreateCustomSettingsRegistry_DB2MINIMIZELISTPREFETCH_No, CreateCustomSettingsRegistry_DB2MINIMIZELISTPREFETCH_Yes},
+		"DB2OBJECTTABLEENTRIES":        {CreateCustomSettingsRegistry_DB2OBJECTTABLEENTRIES_Range065532},
+		"DB2OPTPROFILE":                {CreateCustomSettingsRegistry_DB2OPTPROFILE_No, CreateCustomSettingsRegistry_DB2OPTPROFILE_Yes},
+		"DB2SELECTIVITY":               {CreateCustomSettingsRegistry_DB2SELECTIVITY_All, CreateCustomSettingsRegistry_DB2SELECTIVITY_No, CreateCustomSettingsRegistry_DB2SELECTIVITY_Yes},
+		"DB2SKIPDELETED":               {CreateCustomSettingsRegistry_DB2SKIPDELETED_No, CreateCustomSettingsRegistry_DB2SKIPDELETED_Yes},
+		"DB2SKIPINSERTED":              {CreateCustomSettingsRegistry_DB2SKIPINSERTED_No, CreateCustomSettingsRegistry_DB2SKIPINSERTED_Yes},
+		"DB2SYNCRELEASELOCKATTRIBUTES": {CreateCustomSettingsRegistry_DB2SYNCRELEASELOCKATTRIBUTES_No, CreateCustomSettingsRegistry_DB2SYNCRELEASELOCKATTRIBUTES_Yes},
+		"DB2TRUNCATEREUSESTORAGE":      {CreateCustomSettingsRegistry_DB2TRUNCATEREUSESTORAGE_Import, CreateCustomSettingsRegistry_DB2TRUNCATEREUSESTORAGE_Load, CreateCustomSettingsRegistry_DB2TRUNCATEREUSESTORAGE_Truncate},
+		"DB2USEALTERNATEPAGECLEANING":  {CreateCustomSettingsRegistry_DB2USEALTERNATEPAGECLEANING_Off, CreateCustomSettingsRegistry_DB2USEALTERNATEPAGECLEANING_On},
+		"DB2VIEWREOPTVALUES":           {CreateCustomSettingsRegistry_DB2VIEWREOPTVALUES_No, CreateCustomSettingsRegistry_DB2VIEWREOPTVALUES_Yes},
+		"DB2WORKLOAD":                  {CreateCustomSettingsRegistry_DB2WORKLOAD_Analytics, CreateCustomSettingsRegistry_DB2WORKLOAD_Cm, CreateCustomSettingsRegistry_DB2WORKLOAD_CognosCs, CreateCustomSettingsRegistry_DB2WORKLOAD_FilenetCm, CreateCustomSettingsRegistry_DB2WORKLOAD_InforErpLn, CreateCustomSettingsRegistry_DB2WORKLOAD_Maximo, CreateCustomSettingsRegistry_DB2WORKLOAD_Mdm, CreateCustomSettingsRegistry_DB2WORKLOAD_Sap, CreateCustomSettingsRegistry_DB2WORKLOAD_Tpm, CreateCustomSettingsRegistry_DB2WORKLOAD_Was, CreateCustomSettingsRegistry_DB2WORKLOAD_Wc, CreateCustomSettingsRegistry_DB2WORKLOAD_Wp},
+	},
+	CustomSettingScope_Db: {
+		"ACTSORTMEMLIMIT":    {CreateCustomSettingsDb_ACTSORTMEMLIMIT_None, CreateCustomSettingsDb_ACTSORTMEMLIMIT_Range10100},
+		"ALTCOLLATE":         {CreateCustomSettingsDb_ALTCOLLATE_Identity16bit, CreateCustomSettingsDb_ALTCOLLATE_Null},
+		"APPGROUPMEMSZ":      {CreateCustomSettingsDb_APPGROUPMEMSZ_Range11000000},
+		"APPLHEAPSZ":         {CreateCustomSettingsDb_APPLHEAPSZ_Automatic, CreateCustomSettingsDb_APPLHEAPSZ_Range162147483647},
+		"APPLMEMORY":         {CreateCustomSettingsDb_APPLMEMORY_Automatic, CreateCustomSettingsDb_APPLMEMORY_Range1284294967295},
+		"APPCTLHEAPSZ":       {CreateCustomSettingsDb_APPCTLHEAPSZ_Range164000},
+		"ARCHRETRYDELAY":     {CreateCustomSettingsDb_ARCHRETRYDELAY_Range065535},
+		"AUTHNCACHEDURATION": {CreateCustomSettingsDb_AUTHNCACHEDURATION_Range110000},
+		"AUTORESTART":        {CreateCustomSettingsDb_AUTORESTART_Off, CreateCustomSettingsDb_AUTORESTART_On},
+		"AUTOCGSTATS":        {CreateCustomSettingsDb_AUTOCGSTATS_Off, CreateCustomSettingsDb_AUTOCGSTATS_On},
+		"AUTOMAINT":          {CreateCustomSettingsDb_AUTOMAINT_Off, CreateCustomSettingsDb_AUTOMAINT_On},
+		"AUTOREORG":          {CreateCustomSettingsDb_AUTOREORG_Off, CreateCustomSettingsDb_AUTOREORG_On},
+		"AUTOREVAL":          {CreateCustomSettingsDb_AUTOREVAL_Deferred, CreateCustomSettingsDb_AUTOREVAL_DeferredForce, CreateCustomSettingsDb_AUTOREVAL_Disabled, CreateCustomSettingsDb_AUTOREVAL_Immediate},
+		"AUTORUNSTATS":       {CreateCustomSettingsDb_AUTORUNSTATS_Off, CreateCustomSettingsDb_AUTORUNSTATS_On},
+		"AUTOSAMPLING":       {CreateCustomSettingsDb_AUTOSAMPLING_Off, CreateCustomSettingsDb_AUTOSAMPLING_On},
+		"AUTOSTATSVIEWS":     {CreateCustomSettingsDb_AUTOSTATSVIEWS_Off, CreateCustomSettingsDb_AUTOSTATSVIEWS_On},
+		"AUTOSTMTSTATS":      {CreateCustomSettingsDb_AUTOSTMTSTATS_Off, CreateCustomSettingsDb_AUTOSTMTSTATS_On},
+		"AUTOTBLMAINT":       {CreateCustomSettingsDb_AUTOTBLMAINT_Off, CreateCustomSettingsDb_AUTOTBLMAINT_On},
+		"CHNGPGSTHRESH":      {CreateCustomSettingsDb_CHNGPGSTHRESH_Range599},
+		"CURCOMMIT":          {CreateCustomSettingsDb_CURCOMMIT_Available, CreateCustomSettingsDb_CURCOMMIT_Disabled, CreateCustomSettingsDb_CURCOMMIT_On},
+		"DATABASEMEMORY":     {CreateCustomSettingsDb_DATABASEMEMORY_Automatic, CreateCustomSettingsDb_DATABASEMEMORY_Computed, CreateCustomSettingsDb_DATABASEMEMORY_Range04294967295},
+		"DBHEAP":             {CreateCustomSettingsDb_DBHEAP_Automatic, CreateCustomSettingsDb_DBHEAP_Range322147483647},
+		"DBMEMTHRESH":        {CreateCustomSettingsDb_DBMEMTHRESH_Range0100},
+		"DDLCOMPRESSIONDEF":  {CreateCustomSettingsDb_DDLCOMPRESSIONDEF_No, CreateCustomSettingsDb_DDLCOMPRESSIONDEF_Yes},
+		"DDLCONSTRAINTDEF":   {CreateCustomSettingsDb_DDLCONSTRAINTDEF_No, CreateCustomSettingsDb_DDLCONSTRAINTDEF_Yes},
+		"DECFLTROUNDING":     {CreateCustomSettingsDb_DECFLTROUNDING_RoundCeiling, CreateCustomSettingsDb_DECFLTROUNDING_RoundDown, CreateCustomSettingsDb_DECFLTROUNDING_RoundFloor, CreateCustomSettingsDb_DECFLTROUNDING_RoundHalfEven, CreateCustomSettingsDb_DECFLTROUNDING_RoundHalfUp},
+		"DECTOCHARFMT":       {CreateCustomSettingsDb_DECTOCHARFMT_New, CreateCustomSettingsDb_DECTOCHARFMT_V95},
+		"DFTDEGREE":          {CreateCustomSettingsDb_DFTDEGREE_Any, CreateCustomSettingsDb_DFTDEGREE_Range132767},
+		"DFTEXTENTSZ":        {CreateCustomSettingsDb_DFTEXTENTSZ_Range2256},
+		"DFTLOADRECSES":      {CreateCustomSettingsDb_DFTLOADRECSES_Range130000},
+		"DFTPREFETCHSZ":      {CreateCustomSettingsDb_DFTPREFETCHSZ_Automatic, CreateCustomSettingsDb_DFTPREFETCHSZ_Range032767},
+		"DFTQUERYOPT":        {CreateCustomSettingsDb_DFTQUERYOPT_Range09},
+		"DFTSCHEMASDCC":      {CreateCustomSettingsDb_DFTSCHEMASDCC_No, CreateCustomSettingsDb_DFTSCHEMASDCC_Yes},
+		"DFTSQLMATHWARN":     {CreateCustomSettingsDb_DFTSQLMATHWARN_No, CreateCustomSettingsDb_DFTSQLMATHWARN_Yes},
+		"DFTTABLEORG":        {CreateCustomSettingsDb_DFTTABLEORG_Column, CreateCustomSettingsDb_DFTTABLEORG_Row},
+		"DLCHKTIME":          {CreateCustomSettingsDb_DLCHKTIME_Range1000600000},
+		"ENABLEXMLCHAR":      {CreateCustomSettingsDb_ENABLEXMLCHAR_No, CreateCustomSettingsDb_ENABLEXMLCHAR_Yes},
+		"EXTENDEDROWSZ":      {CreateCustomSettingsDb_EXTENDEDROWSZ_Disable, CreateCustomSettingsDb_EXTENDEDROWSZ_Enable},
+		"GROUPHEAPRATIO":     {CreateCustomSettingsDb_GROUPHEAPRATIO_Range199},
+		"INDEXREC":           {CreateCustomSettingsDb_INDEXREC_Access, CreateCustomSettingsDb_INDEXREC_AccessNoRedo, CreateCustomSettingsDb_INDEXREC_Restart, CreateCustomSettingsDb_INDEXREC_RestartNoRedo, CreateCustomSettingsDb_INDEXREC_System},
+		"LARGEAGGREGATION":   {CreateCustomSettingsDb_LARGEAGGREGATION_No, CreateCustomSettingsDb_LARGEAGGREGATION_Yes},
+		"LOCKLIST":           {CreateCustomSettingsDb_LOCKLIST_Automatic, CreateCustomSettingsDb_LOCKLIST_Range4134217728},
+		"LOCKTIMEOUT":        {CreateCustomSettingsDb_LOCKTIMEOUT_Range032767},
+		"LOGINDEXBUILD":      {CreateCustomSettingsDb_LOGINDEXBUILD_Off, CreateCustomSettingsDb_LOGINDEXBUILD_On},
+		"LOGAPPLINFO":        {CreateCustomSettingsDb_LOGAPPLINFO_No, CreateCustomSettingsDb_LOGAPPLINFO_Yes},
+		"LOGDDLSTMTS":        {CreateCustomSettingsDb_LOGDDLSTMTS_No, CreateCustomSettingsDb_LOGDDLSTMTS_Yes},
+		"LOGDISKCAP":         {CreateCustomSettingsDb_LOGDISKCAP_Range12147483647},
+		"MAXAPPLS":           {CreateCustomSettingsDb_MAXAPPLS_Range160000},
+		"MAXFILOP":           {CreateCustomSettingsDb_MAXFILOP_Range6461440},
+		"MAXLOCKS":           {CreateCustomSettingsDb_MAXLOCKS_Automatic, CreateCustomSettingsDb_MAXLOCKS_Range1100},
+		"MINDECDIV3":         {CreateCustomSettingsDb_MINDECDIV3_No, CreateCustomSettingsDb_MINDECDIV3_Yes},
+		"MONACTMETRICS":      {CreateCustomSettingsDb_MONACTMETRICS_Base, CreateCustomSettingsDb_MONACTMETRICS_Extended, CreateCustomSettingsDb_MONACTMETRICS_None},
+		"MONDEADLOCK":        {CreateCustomSettingsDb_MONDEADLOCK_HistAndValues, CreateCustomSettingsDb_MONDEADLOCK_History, CreateCustomSettingsDb_MONDEADLOCK_None, CreateCustomSettingsDb_MONDEADLOCK_WithoutHist},
+		"MONLCKMSGLVL":       {CreateCustomSettingsDb_MONLCKMSGLVL_Range03},
+		"MONLOCKTIMEOUT":     {CreateCustomSettingsDb_MONLOCKTIMEOUT_HistAndValues, CreateCustomSettingsDb_MONLOCKTIMEOUT_History, CreateCustomSettingsDb_MONLOCKTIMEOUT_None, CreateCustomSettingsDb_MONLOCKTIMEOUT_WithoutHist},
+		"MONLOCKWAIT":        {CreateCustomSettingsDb_MONLOCKWAIT_HistAndValues, CreateCustomSettingsDb_MONLOCKWAIT_History, CreateCustomSettingsDb_MONLOCKWAIT_None, CreateCustomSettingsDb_MONLOCKWAIT_WithoutHist},
+		"MONLWTHRESH":        {CreateCustomSettingsDb_MONLWTHRESH_Range10004294967295},
+		"MONOBJMETRICS":      {CreateCustomSettingsDb_MONOBJMETRICS_Base, CreateCustomSettingsDb_MONOBJMETRICS_Extended, CreateCustomSettingsDb_MONOBJMETRICS_None},
+		"MONPKGLISTSZ":       {CreateCustomSettingsDb_MONPKGLISTSZ_Range01024},
+		"MONREQMETRICS":      {CreateCustomSettingsDb_MONREQMETRICS_Base, CreateCustomSettingsDb_MONREQMETRICS_Extended, CreateCustomSettingsDb_MONREQMETRICS_None},
+		"MONRTNDATA":         {CreateCustomSettingsDb_MONRTNDATA_Base, CreateCustomSettingsDb_MONRTNDATA_None},
+		"MONRTNEXECLIST":     {CreateCustomSettingsDb_MONRTNEXECLIST_Off, CreateCustomSettingsDb_MONRTNEXECLIST_On},
+		"MONUOWDATA":         {CreateCustomSettingsDb_MONUOWDATA_Base, CreateCustomSettingsDb_MONUOWDATA_None},
+		"MONUOWEXECLIST":     {CreateCustomSettingsDb_MONUOWEXECLIST_Off, CreateCustomSettingsDb_MONUOWEXECLIST_On},
+		"MONUOWPKGLIST":      {CreateCustomSettingsDb_MONUOWPKGLIST_Off, CreateCustomSettingsDb_MONUOWPKGLIST_On},
+		"NCHARMAPPING":       {CreateCustomSettingsDb_NCHARMAPPING_CharCu32, CreateCustomSettingsDb_NCHARMAPPING_GraphicCu16, CreateCustomSettingsDb_NCHARMAPPING_GraphicCu32, CreateCustomSettingsDb_NCHARMAPPING_NotApplicable},
+		"NUMFREQVALUES":      {CreateCustomSettingsDb_NUMFREQVALUES_Range032767},
+		"NUMIOCLEANERS":      {CreateCustomSettingsDb_NUMIOCLEANERS_Automatic, CreateCustomSettingsDb_NUMIOCLEANERS_Range0255},
+		"NUMIOSERVERS":       {CreateCustomSettingsDb_NUMIOSERVERS_Automatic, CreateCustomSettingsDb_NUMIOSERVERS_Range1255},
+		"NUMLOGSPAN":         {CreateCustomSettingsDb_NUMLOGSPAN_Range065535},
+		"NUMQUANTILES":       {CreateCustomSettingsDb_NUMQUANTILES_Range032767},
+		"OPTDIRECTWRKLD":     {CreateCustomSettingsDb_OPTDIRECTWRKLD_Automatic, CreateCustomSettingsDb_OPTDIRECTWRKLD_No, CreateCustomSettingsDb_OPTDIRECTWRKLD_Off, CreateCustomSettingsDb_OPTDIRECTWRKLD_On, CreateCustomSettingsDb_OPTDIRECTWRKLD_Yes},
+		"PAGEAGETRGTGCR":     {CreateCustomSettingsDb_PAGEAGETRGTGCR_Range165535},
+		"PAGEAGETRGTMCR":     {CreateCustomSettingsDb_PAGEAGETRGTMCR_Range165535},
+		"PCKCACHESZ":         {CreateCustomSettingsDb_PCKCACHESZ_Automatic, CreateCustomSettingsDb_PCKCACHESZ_Range322147483646},
+		"PLSTACKTRACE":       {CreateCustomSettingsDb_PLSTACKTRACE_All, CreateCustomSettingsDb_PLSTACKTRACE_None, CreateCustomSettingsDb_PLSTACKTRACE_Unhandled},
+		"SELFTUNINGMEM":      {CreateCustomSettingsDb_SELFTUNINGMEM_Off, CreateCustomSettingsDb_SELFTUNINGMEM_On},
+		"SEQDETECT":          {CreateCustomSettingsDb_SEQDETECT_No, CreateCustomSettingsDb_SEQDETECT_Yes},
+		"SHEAPTHRESSHR":      {CreateCustomSettingsDb_SHEAPTHRESSHR_Automatic, CreateCustomSettingsDb_SHEAPTHRESSHR_Range2502147483647},
+		"SORTHEAP":           {CreateCustomSettingsDb_SORTHEAP_Automatic, CreateCustomSettingsDb_SORTHEAP_Range164294967295},
+		"STATHEAPSZ":         {CreateCustomSettingsDb_STATHEAPSZ_Automatic, CreateCustomSettingsDb_STATHEAPSZ_Range10962147483647},
+		"STMTHEAP":           {CreateCustomSettingsDb_STMTHEAP_Automatic, CreateCustomSettingsDb_STMTHEAP_Range1282147483647},
+		"STMTCONC":           {CreateCustomSettingsDb_STMTCONC_CommLit, CreateCustomSettingsDb_STMTCONC_Comments, CreateCustomSettingsDb_STMTCONC_Literals, CreateCustomSettingsDb_STMTCONC_Off},
+		"STRINGUNITS":        {CreateCustomSettingsDb_STRINGUNITS_Codeunits32, CreateCustomSettingsDb_STRINGUNITS_System},
+		"SYSTIMEPERIODADJ":   {CreateCustomSettingsDb_SYSTIMEPERIODADJ_No, CreateCustomSettingsDb_SYSTIMEPERIODADJ_Yes},
+		"TRACKMOD":           {CreateCustomSettingsDb_TRACKMOD_No, CreateCustomSettingsDb_TRACKMOD_Yes},
+		"UTILHEAPSZ":         {CreateCustomSettingsDb_UTILHEAPSZ_Automatic, CreateCustomSettingsDb_UTILHEAPSZ_Range162147483647},
+		"WLMADMISSIONCTRL":   {CreateCustomSettingsDb_WLMADMISSIONCTRL_No, CreateCustomSettingsDb_WLMADMISSIONCTRL_Yes},
+		"WLMAGENTLOADTRGT":   {CreateCustomSettingsDb_WLMAGENTLOADTRGT_Automatic, CreateCustomSettingsDb_WLMAGENTLOADTRGT_Range165535},
+		"WLMCPULIMIT":        {CreateCustomSettingsDb_WLMCPULIMIT_Range0100},
+		"WLMCPUSHARES":       {CreateCustomSettingsDb_WLMCPUSHARES_Range165535},
+		"WLMCPUSHAREMODE":    {CreateCustomSettingsDb_WLMCPUSHAREMODE_Hard, CreateCustomSettingsDb_WLMCPUSHAREMODE_Soft},
+	},
+	CustomSettingScope_Dbm: {
+		"COMMBANDWIDTH":    {CreateCustomSettingsDbm_COMMBANDWIDTH_Range01100000},
+		"CPUSPEED":         {CreateCustomSettingsDbm_CPUSPEED_Range000000000011},
+		"DFTMONBUFPOOL":    {CreateCustomSettingsDbm_DFTMONBUFPOOL_Off, CreateCustomSettingsDbm_DFTMONBUFPOOL_On},
+		"DFTMONLOCK":       {CreateCustomSettingsDbm_DFTMONLOCK_Off, CreateCustomSettingsDbm_DFTMONLOCK_On},
+		"DFTMONSORT":       {CreateCustomSettingsDbm_DFTMONSORT_Off, CreateCustomSettingsDbm_DFTMONSORT_On},
+		"DFTMONSTMT":       {CreateCustomSettingsDbm_DFTMONSTMT_Off, CreateCustomSettingsDbm_DFTMONSTMT_On},
+		"DFTMONTABLE":      {CreateCustomSettingsDbm_DFTMONTABLE_Off, CreateCustomSettingsDbm_DFTMONTABLE_On},
+		"DFTMONTIMESTAMP":  {CreateCustomSettingsDbm_DFTMONTIMESTAMP_Off, CreateCustomSettingsDbm_DFTMONTIMESTAMP_On},
+		"DFTMONUOW":        {CreateCustomSettingsDbm_DFTMONUOW_Off, CreateCustomSettingsDbm_DFTMONUOW_On},
+		"DIAGLEVEL":        {CreateCustomSettingsDbm_DIAGLEVEL_Range04},
+		"FEDERATEDASYNC":   {CreateCustomSettingsDbm_FEDERATEDASYNC_Any, CreateCustomSettingsDbm_FEDERATEDASYNC_Range032767},
+		"INDEXREC":         {CreateCustomSettingsDbm_INDEXREC_Access, CreateCustomSettingsDbm_INDEXREC_AccessNoRedo, CreateCustomSettingsDbm_INDEXREC_Restart, CreateCustomSettingsDbm_INDEXREC_RestartNoRedo},
+		"INTRAPARALLEL":    {CreateCustomSettingsDbm_INTRAPARALLEL_No, CreateCustomSettingsDbm_INTRAPARALLEL_System, CreateCustomSettingsDbm_INTRAPARALLEL_Yes},
+		"KEEPFENCED":       {CreateCustomSettingsDbm_KEEPFENCED_No, CreateCustomSettingsDbm_KEEPFENCED_Yes},
+		"MAXCONNRETRIES":   {CreateCustomSettingsDbm_MAXCONNRETRIES_Range0100},
+		"MAXQUERYDEGREE":   {CreateCustomSettingsDbm_MAXQUERYDEGREE_Any, CreateCustomSettingsDbm_MAXQUERYDEGREE_Range132767},
+		"MONHEAPSZ":        {CreateCustomSettingsDbm_MONHEAPSZ_Automatic, CreateCustomSettingsDbm_MONHEAPSZ_Range02147483647},
+		"MULTIPARTSIZEMB":  {CreateCustomSettingsDbm_MULTIPARTSIZEMB_Range55120},
+		"NOTIFYLEVEL":      {CreateCustomSettingsDbm_NOTIFYLEVEL_Range04},
+		"NUMINITAGENTS":    {CreateCustomSettingsDbm_NUMINITAGENTS_Range064000},
+		"NUMINITFENCED":    {CreateCustomSettingsDbm_NUMINITFENCED_Range064000},
+		"NUMPOOLAGENTS":    {CreateCustomSettingsDbm_NUMPOOLAGENTS_Range064000},
+		"RESYNCINTERVAL":   {CreateCustomSettingsDbm_RESYNCINTERVAL_Range160000},
+		"RQRIOBLK":         {CreateCustomSettingsDbm_RQRIOBLK_Range409665535},
+		"STARTSTOPTIME":    {CreateCustomSettingsDbm_STARTSTOPTIME_Range11440},
+		"UTILIMPACTLIM":    {CreateCustomSettingsDbm_UTILIMPACTLIM_Range1100},
+		"WLMDISPATCHER":    {CreateCustomSettingsDbm_WLMDISPATCHER_No, CreateCustomSettingsDbm_WLMDISPATCHER_Yes},
+		"WLMDISPCONCUR":    {CreateCustomSettingsDbm_WLMDISPCONCUR_Computed, CreateCustomSettingsDbm_WLMDISPCONCUR_Range132767},
+		"WLMDISPCPUSHARES": {CreateCustomSettingsDbm_WLMDISPCPUSHARES_No, CreateCustomSettingsDbm_WLMDISPCPUSHARES_Yes},
+		"WLMDISPMINUTIL":   {CreateCustomSettingsDbm_WLMDISPMINUTIL_Range0100},
+	},
+}
@@ -0,0 +1,188 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1_test
+
+import (
+	"errors"
+
+	"github.com/IBM/cloud-db2-go-sdk/db2saasfake"
+	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Custom setting rules`, func() {
+	It(`Build the rules from the model constants`, func() {
+		rules := db2saasv1.CustomSettingRules()
+		Expect(rules).To(HaveLen(143))
+		scopes := map[string]int{}
+		for _, rule := range rules {
+			scopes[rule.Scope]++
+			Expect(len(rule.Keywords)+len(rule.Ranges)).To(BeNumerically(">", 0), rule.Name)
+		}
+		Expect(scopes).To(Equal(map[string]int{"db": 92, "dbm": 30, "registry": 21}))
+
+		rule, ok := db2saasv1.GetCustomSettingRule(db2saasv1.CustomSettingScope_Db, "UTIL_HEAP_SZ")
+		Expect(ok).To(BeTrue())
+		Expect(rule.Property).To(Equal("UTILHEAPSZ"))
+		Expect(rule.Keywords).To(Equal([]string{db2saasv1.CreateCustomSettingsDb_UTILHEAPSZ_Automatic}))
+		Expect(rule.Ranges).To(Equal([]db2saasv1.CustomSettingRange{{Min: 16, Max: 2147483647, Integer: true}}))
+		Expect(rule.Domain()).To(Equal("'AUTOMATIC' or an integer from 16 to 2147483647"))
+
+		rule, ok = db2saasv1.GetCustomSettingRule(db2saasv1.CustomSettingScope_Dbm, "CPUSPEED")
+		Expect(ok).To(BeTrue())
+		Expect(rule.Keywords).To(Equal([]string{"-1"}))
+		Expect(rule.Ranges).To(Equal([]db2saasv1.CustomSettingRange{{Min: 0.0000000001, Max: 1}}))
+		Expect(rule.Domain()).To(Equal("'-1' or a number from 0.0000000001 to 1"))
+
+		rule, ok = db2saasv1.GetCustomSettingRule(db2saasv1.CustomSettingScope_Registry, "DB2WORKLOAD")
+		Expect(ok).To(BeTrue())
+		Expect(rule.Keywords).To(ContainElements("ANALYTICS", "SAP"))
+		Expect(rule.Ranges).To(BeEmpty())
+
+		_, ok = db2saasv1.GetCustomSettingRule(db2saasv1.CustomSettingScope_Registry, "DB2_COMPATIBILITY_VECTOR")
+		Expect(ok).To(BeFalse())
+		_, ok = db2saasv1.GetCustomSettingRule(db2saasv1.CustomSettingScope_Dbm, "UTIL_HEAP_SZ")
+		Expect(ok).To(BeFalse())
+	})
+	It(`Check values against the rules`, func() {
+		rule, _ := db2saasv1.GetCustomSettingRule(db2saasv1.CustomSettingScope_Db, "UTILHEAPSZ")
+		for value, allowed := range map[string]bool{
+			"AUTOMATIC":  true,
+			"16":         true,
+			"2147483647": true,
+			"15":         false,
+			"2147483648": false,
+			"1e3":        false,
+			"100.5":      false,
+			"automatic":  false,
+			"":           false,
+		} {
+			Expect(rule.Allows(value)).To(Equal(allowed), value)
+		}
+		rule, _ = db2saasv1.GetCustomSettingRule(db2saasv1.CustomSettingScope_Dbm, "COMM_BANDWIDTH")
+		Expect(rule.Allows("0.1")).To(BeTrue())
+		Expect(rule.Allows("250.75")).To(BeTrue())
+		Expect(rule.Allows("0.05")).To(BeFalse())
+		Expect(rule.Allows("NaN")).To(BeFalse())
+	})
+	It(`Check the Db2 special values against the rules`, func() {
+		// Every value of the GetDb2SaasTuneableParam domains that the model constants leave out.
+		for _, setting := range []struct {
+			scope, name, value string
+		}{
+			{db2saasv1.CustomSettingScope_Registry, "DB2_WORKLOAD", "1C"},
+			{db2saasv1.CustomSettingScope_Db, "DFT_DEGREE", "-1"},
+			{db2saasv1.CustomSettingScope_Db, "LOCKTIMEOUT", "-1"},
+			{db2saasv1.CustomSettingScope_Db, "LOG_DISK_CAP", "0"},
+			{db2saasv1.CustomSettingScope_Db, "LOG_DISK_CAP", "-1"},
+			{db2saasv1.CustomSettingScope_Db, "PCKCACHESZ", "-1"},
+			{db2saasv1.CustomSettingScope_Dbm, "COMM_BANDWIDTH", "-1"},
+			{db2saasv1.CustomSettingScope_Dbm, "CPUSPEED", "-1"},
+			{db2saasv1.CustomSettingScope_Dbm, "FEDERATED_ASYNC", "-1"},
+			{db2saasv1.CustomSettingScope_Dbm, "MAX_QUERYDEGREE", "-1"},
+			{db2saasv1.CustomSettingScope_Dbm, "NUM_POOLAGENTS", "-1"},
+		} {
+			rule, ok := db2saasv1.GetCustomSettingRule(setting.scope, setting.name)
+			Expect(ok).To(BeTrue(), setting.name)
+			Expect(rule.Allows(setting.value)).To(BeTrue(), setting.name+" "+setting.value)
+		}
+
+		rule, _ := db2saasv1.GetCustomSettingRule(db2saasv1.CustomSettingScope_Db, "LOCKTIMEOUT")
+		Expect(rule.Allows("-2")).To(BeFalse())
+		Expect(rule.Domain()).To(Equal("'-1' or an integer from 0 to 32767"))
+	})
+	It(`Validate custom settings models`, func() {
+		Expect((&db2saasv1.CreateCustomSettingsDb{}).Validate()).To(BeNil())
+		Expect((&db2saasv1.CreateCustomSettingsDb{
+			LOCKTIMEOUT: core.StringPtr("-1"),
+			DFTDEGREE:   core.StringPtr("-1"),
+			LOGDISKCAP:  core.StringPtr("0"),
+		}).Validate()).To(BeNil())
+		Expect((&db2saasv1.CreateCustomSettingsDb{
+			UTILHEAPSZ:    core.StringPtr("AUTOMATIC"),
+			LOCKTIMEOUT:   core.StringPtr("30"),
+			DBCOLLNAME:    core.StringPtr("anything"),
+			AUTOMAINT:     core.StringPtr("ON"),
+			DFTDEGREE:     core.StringPtr("ANY"),
+			MONREQMETRICS: core.StringPtr("BASE"),
+		}).Validate()).To(BeNil())
+
+		err := (&db2saasv1.CreateCustomSettingsRegistry{DB2WORKLOAD: core.StringPtr("sap")}).Validate()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("registry.DB2_WORKLOAD: invalid value 'sap', must be 'ANALYTICS', 'CM'"))
+	})
+	Describe(`PostDb2SaasDbConfiguration validation`, func() {
+		var fakeServer *db2saasfake.Server
+		var db2saasService *db2saasv1.Db2saasV1
+		BeforeEach(func() {
+			var err error
+			fakeServer = db2saasfake.NewServer()
+			db2saasService, err = fakeServer.NewService()
+			Expect(err).To(BeNil())
+		})
+		AfterEach(func() {
+			fakeServer.Close()
+		})
+
+		It(`Invoke PostDb2SaasDbConfiguration successfully`, func() {
+			postDb2SaasDbConfigurationOptionsModel := db2saasService.NewPostDb2SaasDbConfigurationOptions(fakeProfile).
+				SetDb(&db2saasv1.CreateCustomSettingsDb{UTILHEAPSZ: core.StringPtr("5000")}).
+				SetDbm(&db2saasv1.CreateCustomSettingsDbm{COMMBANDWIDTH: core.StringPtr("100")})
+			_, _, err := db2saasService.PostDb2SaasDbConfiguration(postDb2SaasDbConfigurationOptionsModel)
+			Expect(err).To(BeNil())
+			Expect(fakeServer.Calls(db2saasfake.RoutePostDbConfiguration)).To(Equal(1))
+		})
+		It(`Invoke PostDb2SaasDbConfiguration with error: Invalid settings`, func() {
+			postDb2SaasDbConfigurationOptionsModel := db2saasService.NewPostDb2SaasDbConfigurationOptions(fakeProfile).
+				SetRegistry(&db2saasv1.CreateCustomSettingsRegistry{DB2BIDI: core.StringPtr("MAYBE")}).
+				SetDb(&db2saasv1.CreateCustomSettingsDb{UTILHEAPSZ: core.StringPtr("8"), LOCKTIMEOUT: core.StringPtr("30")}).
+				SetDbm(&db2saasv1.CreateCustomSettingsDbm{CPUSPEED: core.StringPtr("-2")})
+			_, _, err := db2saasService.PostDb2SaasDbConfiguration(postDb2SaasDbConfigurationOptionsModel)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(Equal("3 invalid custom setting(s): " +
+				"registry.DB2BIDI: invalid value 'MAYBE', must be 'NO' or 'YES'; " +
+				"db.UTIL_HEAP_SZ: invalid value '8', must be 'AUTOMATIC' or an integer from 16 to 2147483647; " +
+				"dbm.CPUSPEED: invalid value '-2', must be '-1' or a number from 0.0000000001 to 1"))
+
+			var settingsErr *db2saasv1.CustomSettingsError
+			Expect(errors.As(err, &settingsErr)).To(BeTrue())
+			Expect(settingsErr.Violations).To(HaveLen(3))
+			Expect(settingsErr.Violations[1]).To(Equal(db2saasv1.CustomSettingViolation{
+				Scope:  db2saasv1.CustomSettingScope_Db,
+				Name:   "UTIL_HEAP_SZ",
+				Value:  "8",
+				Domain: "'AUTOMATIC' or an integer from 16 to 2147483647",
+			}))
+			Expect(fakeServer.Calls(db2saasfake.RoutePostDbConfiguration)).To(BeZero())
+		})
+		It(`Invoke PostDb2SaasDbConfiguration successfully: Validation turned off`, func() {
+			Expect(db2saasService.GetValidateCustomSettings()).To(BeTrue())
+			db2saasService.SetValidateCustomSettings(false)
+			Expect(db2saasService.GetValidateCustomSettings()).To(BeFalse())
+
+			postDb2SaasDbConfigurationOptionsModel := db2saasService.NewPostDb2SaasDbConfigurationOptions(fakeProfile).
+				SetDb(&db2saasv1.CreateCustomSettingsDb{UTILHEAPSZ: core.StringPtr("8")})
+			_, _, err := db2saasService.PostDb2SaasDbConfiguration(postDb2SaasDbConfigurationOptionsModel)
+			Expect(err).To(BeNil())
+			value, ok := fakeServer.Setting(fakeCRN, "db", "UTIL_HEAP_SZ")
+			Expect(ok).To(BeTrue())
+			Expect(value).To(Equal("8"))
+		})
+	})
+})
@@ -27,10 +27,12 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"time"
 
 	common "github.com/IBM/cloud-db2-go-sdk/common"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
 )
 
 // Db2saasV1 : Manage lifecycle of your Db2 on Cloud resources using the  APIs.
@@ -38,6 +40,18 @@ import (
 // API Version: 1.0.0
 type Db2saasV1 struct {
 	Service *core.BaseService
+
+	// keepPasswords disables the redaction of the passwords returned by the user operations.
+	keepPasswords bool
+
+	// passwordPolicy is the policy set by SetPasswordPolicy; nil disables the check.
+	passwordPolicy *PasswordPolicy
+
+	// skipSettingsValidation disables the check of the custom settings sent by PostDb2SaasDbConfiguration.
+	skipSettingsValidation bool
+
+	// skipAllowlistValidation disables the check of the allowlist sent by PostDb2SaasAllowlist.
+	skipAllowlistValidation bool
 }
 
 // DefaultServiceURL is the default URL to make service requests to.
@@ -52,11 +66,41 @@ var defaultUrlVariables = map[string]string{
 	"region": "us-south",
 }
 
+// PropertyRegion is the external configuration property (e.g. DB2SAAS_REGION) used to select a regional endpoint.
+const PropertyRegion = "REGION"
+
+// Regions lists the regions in which the service is available.
+var Regions = []string{
+	"au-syd",
+	"br-sao",
+	"ca-tor",
+	"eu-de",
+	"eu-es",
+	"eu-gb",
+	"jp-osa",
+	"jp-tok",
+	"us-east",
+	"us-south",
+}
+
+// UnknownRegionError is returned (wrapped in an SDK problem) when a region is not one of Regions.
+type UnknownRegionError struct {
+	Region string
+}
+
+// Error implements the error interface.
+func (e *UnknownRegionError) Error() string {
+	return fmt.Sprintf("unknown region '%s'; valid regions: %s", e.Region, strings.Join(Regions, ", "))
+}
+
 // Db2saasV1Options : Service options
 type Db2saasV1Options struct {
 	ServiceName   string
 	URL           string
 	Authenticator core.Authenticator
+
+	// Region selects the regional endpoint to use when URL is not specified.
+	Region string
 }
 
 // NewDb2saasV1UsingExternalConfig : constructs an instance of Db2saasV1 with passed in options and external configuration.
@@ -85,6 +129,11 @@ func NewDb2saasV1UsingExternalConfig(options *Db2saasV1Options) (db2saas *Db2saa
 		return
 	}
 
+	err = db2saas.configureRegion(options)
+	if err != nil {
+		return
+	}
+
 	if options.URL != "" {
 		err = db2saas.Service.SetServiceURL(options.URL)
 		err = core.RepurposeSDKProblem(err, "url-set-error")
@@ -111,6 +160,18 @@ func NewDb2saasV1(options *Db2saasV1Options) (service *Db2saasV1, err error) {
 			err = core.SDKErrorf(err, "", "set-url-error", common.GetComponentInfo())
 			return
 		}
+	} else if options.Region != "" {
+		var regionalURL string
+		regionalURL, err = GetServiceURLForRegion(options.Region)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "region-url-error")
+			return
+		}
+		err = baseService.SetServiceURL(regionalURL)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "set-url-error", common.GetComponentInfo())
+			return
+		}
 	}
 
 	service = &Db2saasV1{
@@ -122,7 +183,57 @@ func NewDb2saasV1(options *Db2saasV1Options) (service *Db2saasV1, err error) {
 
 // GetServiceURLForRegion returns the service URL to be used for the specified region
 func GetServiceURLForRegion(region string) (string, error) {
-	return "", core.SDKErrorf(nil, "service does not support regional URLs", "no-regional-support", common.GetComponentInfo())
+	if !isKnownRegion(region) {
+		return "", core.SDKErrorf(&UnknownRegionError{Region: region}, "", "unknown-region", common.GetComponentInfo())
+	}
+	return ConstructServiceURL(map[string]string{"region": region})
+}
+
+// isKnownRegion returns true if "region" is one of the supported regions.
+func isKnownRegion(region string) bool {
+	for _, r := range Regions {
+		if r == region {
+			return true
+		}
+	}
+	return false
+}
+
+// configureRegion points the client at a regional endpoint after external configuration has been loaded.
+// An explicit options.URL always wins; options.Region overrides any configured URL; otherwise the
+// REGION property is used when no URL property is configured.
+func (db2saas *Db2saasV1) configureRegion(options *Db2saasV1Options) (err error) {
+	if options.URL != "" {
+		return
+	}
+
+	region := options.Region
+	if region == "" {
+		var props map[string]string
+		props, err = core.GetServiceProperties(options.ServiceName)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "get-props-error", common.GetComponentInfo())
+			return
+		}
+		if props[core.PROPNAME_SVC_URL] != "" {
+			return
+		}
+		region = props[PropertyRegion]
+	}
+	if region == "" {
+		return
+	}
+
+	regionalURL, err := GetServiceURLForRegion(region)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "region-url-error")
+		return
+	}
+	err = db2saas.Service.SetServiceURL(regionalURL)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-set-error", common.GetComponentInfo())
+	}
+	return
 }
 
 // Clone makes a copy of "db2saas" suitable for processing requests.
@@ -252,6 +363,9 @@ func (db2saas *Db2saasV1) GetDb2SaasConnectionInfoWithContext(ctx context.Contex
 }
 
 // PostDb2SaasAllowlist : Allow listing of new IPs
+// The allowlist is checked with ValidateAllowlist before the request is sent: entries that do not parse, or that
+// duplicate or overlap another entry, are rejected with an AllowlistValidationError. Turn the check off with
+// SetValidateAllowlist.
 func (db2saas *Db2saasV1) PostDb2SaasAllowlist(postDb2SaasAllowlistOptions *PostDb2SaasAllowlistOptions) (result *SuccessPostAllowedlistIPs, response *core.DetailedResponse, err error) {
 	result, response, err = db2saas.PostDb2SaasAllowlistWithContext(context.Background(), postDb2SaasAllowlistOptions)
 	err = core.RepurposeSDKProblem(err, "")
@@ -270,6 +384,11 @@ func (db2saas *Db2saasV1) PostDb2SaasAllowlistWithContext(ctx context.Context, p
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = db2saas.validateAllowlist(postDb2SaasAllowlistOptions.IpAddresses)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "allowlist-validation-error", common.GetComponentInfo())
+		return
+	}
 
 	builder := core.NewRequestBuilder(core.POST)
 	builder = builder.WithContext(ctx)
@@ -415,6 +534,16 @@ func (db2saas *Db2saasV1) PostDb2SaasUserWithContext(ctx context.Context, postDb
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = postDb2SaasUserOptions.validateEnums()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "enum-validation-error", common.GetComponentInfo())
+		return
+	}
+	err = db2saas.validatePassword(postDb2SaasUserOptions.Password, postDb2SaasUserOptions.ID)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "password-policy-error", common.GetComponentInfo())
+		return
+	}
 
 	builder := core.NewRequestBuilder(core.POST)
 	builder = builder.WithContext(ctx)
@@ -492,6 +621,9 @@ func (db2saas *Db2saasV1) PostDb2SaasUserWithContext(ctx context.Context, postDb
 			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
 			return
 		}
+		if db2saas.redactPasswords(ctx) {
+			result.RedactPassword()
+		}
 		response.Result = result
 	}
 
@@ -559,6 +691,9 @@ func (db2saas *Db2saasV1) GetDb2SaasUserWithContext(ctx context.Context, getDb2S
 			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
 			return
 		}
+		if db2saas.redactPasswords(ctx) {
+			result.RedactPassword()
+		}
 		response.Result = result
 	}
 
@@ -584,6 +719,11 @@ func (db2saas *Db2saasV1) PutDb2SaasUserWithContext(ctx context.Context, putDb2S
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = putDb2SaasUserOptions.validateEnums()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "enum-validation-error", common.GetComponentInfo())
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"id": *putDb2SaasUserOptions.ID,
@@ -665,6 +805,9 @@ func (db2saas *Db2saasV1) PutDb2SaasUserWithContext(ctx context.Context, putDb2S
 			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
 			return
 		}
+		if db2saas.redactPasswords(ctx) {
+			result.RedactPassword()
+		}
 		response.Result = result
 	}
 
@@ -797,6 +940,9 @@ func (db2saas *Db2saasV1) GetbyidDb2SaasUserWithContext(ctx context.Context, get
 			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
 			return
 		}
+		if db2saas.redactPasswords(ctx) {
+			result.RedactPassword()
+		}
 		response.Result = result
 	}
 
@@ -822,6 +968,11 @@ func (db2saas *Db2saasV1) PutDb2SaasAutoscaleWithContext(ctx context.Context, pu
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = putDb2SaasAutoscaleOptions.validateEnums()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "enum-validation-error", common.GetComponentInfo())
+		return
+	}
 
 	builder := core.NewRequestBuilder(core.PUT)
 	builder = builder.WithContext(ctx)
@@ -979,6 +1130,11 @@ func (db2saas *Db2saasV1) PostDb2SaasDbConfigurationWithContext(ctx context.Cont
 		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
 		return
 	}
+	err = db2saas.validateSettings(ctx, postDb2SaasDbConfigurationOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "settings-validation-error", common.GetComponentInfo())
+		return
+	}
 
 	builder := core.NewRequestBuilder(core.POST)
 	builder = builder.WithContext(ctx)
@@ -1077,6 +1233,9 @@ func (db2saas *Db2saasV1) GetDb2SaasTuneableParamWithContext(ctx context.Context
 		builder.AddHeader(headerName, headerValue)
 	}
 	builder.AddHeader("Accept", "application/json")
+	if getDb2SaasTuneableParamOptions.XDbProfile != nil {
+		builder.AddHeader("x-db-profile", fmt.Sprint(*getDb2SaasTuneableParamOptions.XDbProfile))
+	}
 
 	request, err := builder.Build()
 	if err != nil {
@@ -1145,6 +1304,28 @@ func (db2saas *Db2saasV1) GetDb2SaasBackupWithContext(ctx context.Context, getDb
 		builder.AddHeader("x-db-profile", fmt.Sprint(*getDb2SaasBackupOptions.XDbProfile))
 	}
 
+	if getDb2SaasBackupOptions.Type != nil {
+		builder.AddQuery("type", fmt.Sprint(*getDb2SaasBackupOptions.Type))
+	}
+	if getDb2SaasBackupOptions.Status != nil {
+		builder.AddQuery("status", fmt.Sprint(*getDb2SaasBackupOptions.Status))
+	}
+	if getDb2SaasBackupOptions.CreatedAfter != nil {
+		builder.AddQuery("created_after", fmt.Sprint(*getDb2SaasBackupOptions.CreatedAfter))
+	}
+	if getDb2SaasBackupOptions.CreatedBefore != nil {
+		builder.AddQuery("created_before", fmt.Sprint(*getDb2SaasBackupOptions.CreatedBefore))
+	}
+	if getDb2SaasBackupOptions.Sort != nil {
+		builder.AddQuery("sort", fmt.Sprint(*getDb2SaasBackupOptions.Sort))
+	}
+	if getDb2SaasBackupOptions.Limit != nil {
+		builder.AddQuery("limit", fmt.Sprint(*getDb2SaasBackupOptions.Limit))
+	}
+	if getDb2SaasBackupOptions.Start != nil {
+		builder.AddQuery("start", fmt.Sprint(*getDb2SaasBackupOptions.Start))
+	}
+
 	request, err := builder.Build()
 	if err != nil {
 		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
@@ -1236,6 +1417,159 @@ func (db2saas *Db2saasV1) PostDb2SaasBackupWithContext(ctx context.Context, post
 
 	return
 }
+
+// GetDb2SaasRestore : Get restores of an instance
+func (db2saas *Db2saasV1) GetDb2SaasRestore(getDb2SaasRestoreOptions *GetDb2SaasRestoreOptions) (result *SuccessGetRestores, response *core.DetailedResponse, err error) {
+	result, response, err = db2saas.GetDb2SaasRestoreWithContext(context.Background(), getDb2SaasRestoreOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetDb2SaasRestoreWithContext is an alternate form of the GetDb2SaasRestore method which supports a Context parameter
+func (db2saas *Db2saasV1) GetDb2SaasRestoreWithContext(ctx context.Context, getDb2SaasRestoreOptions *GetDb2SaasRestoreOptions) (result *SuccessGetRestores, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(getDb2SaasRestoreOptions, "getDb2SaasRestoreOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(getDb2SaasRestoreOptions, "getDb2SaasRestoreOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = db2saas.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(db2saas.Service.Options.URL, `/manage/backups/restore`, nil)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+
+	sdkHeaders := common.GetSdkHeaders("db2saas", "V1", "GetDb2SaasRestore")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	for headerName, headerValue := range getDb2SaasRestoreOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", "application/json")
+	if getDb2SaasRestoreOptions.XDbProfile != nil {
+		builder.AddHeader("x-db-profile", fmt.Sprint(*getDb2SaasRestoreOptions.XDbProfile))
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err = db2saas.Service.Request(request, &rawResponse)
+	if err != nil {
+		core.EnrichHTTPProblem(err, "get_db2_saas_restore", getServiceComponentInfo())
+		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		return
+	}
+	if rawResponse != nil {
+		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSuccessGetRestores)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			return
+		}
+		response.Result = result
+	}
+
+	return
+}
+
+// PostDb2SaasRestore : Restore an instance from a backup
+func (db2saas *Db2saasV1) PostDb2SaasRestore(postDb2SaasRestoreOptions *PostDb2SaasRestoreOptions) (result *SuccessCreateRestore, response *core.DetailedResponse, err error) {
+	result, response, err = db2saas.PostDb2SaasRestoreWithContext(context.Background(), postDb2SaasRestoreOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PostDb2SaasRestoreWithContext is an alternate form of the PostDb2SaasRestore method which supports a Context parameter
+func (db2saas *Db2saasV1) PostDb2SaasRestoreWithContext(ctx context.Context, postDb2SaasRestoreOptions *PostDb2SaasRestoreOptions) (result *SuccessCreateRestore, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(postDb2SaasRestoreOptions, "postDb2SaasRestoreOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(postDb2SaasRestoreOptions, "postDb2SaasRestoreOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	err = postDb2SaasRestoreOptions.validateTarget()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "restore-target-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	builder := core.NewRequestBuilder(core.POST)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = db2saas.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(db2saas.Service.Options.URL, `/manage/backups/restore`, nil)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+
+	sdkHeaders := common.GetSdkHeaders("db2saas", "V1", "PostDb2SaasRestore")
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	for headerName, headerValue := range postDb2SaasRestoreOptions.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddHeader("Content-Type", "application/json")
+	if postDb2SaasRestoreOptions.XDbProfile != nil {
+		builder.AddHeader("x-db-profile", fmt.Sprint(*postDb2SaasRestoreOptions.XDbProfile))
+	}
+
+	body := make(map[string]interface{})
+	if postDb2SaasRestoreOptions.BackupID != nil {
+		body["backup_id"] = postDb2SaasRestoreOptions.BackupID
+	}
+	if postDb2SaasRestoreOptions.TargetTime != nil {
+		body["target_time"] = postDb2SaasRestoreOptions.TargetTime
+	}
+	_, err = builder.SetBodyContentJSON(body)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "set-json-body-error", common.GetComponentInfo())
+		return
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err = db2saas.Service.Request(request, &rawResponse)
+	if err != nil {
+		core.EnrichHTTPProblem(err, "post_db2_saas_restore", getServiceComponentInfo())
+		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		return
+	}
+	if rawResponse != nil {
+		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalSuccessCreateRestore)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			return
+		}
+		response.Result = result
+	}
+
+	return
+}
 func getServiceComponentInfo() *core.ProblemComponent {
 	return core.NewProblemComponent(DefaultServiceName, "1.0.0")
 }
@@ -3764,10 +4098,45 @@ type GetDb2SaasBackupOptions struct {
 	// Encoded CRN deployment id.
 	XDbProfile *string `json:"x-db-profile" validate:"required"`
 
+	// Only return backups of this type.
+	Type *string `json:"type,omitempty"`
+
+	// Only return backups with this status.
+	Status *string `json:"status,omitempty"`
+
+	// Only return backups created at or after this time.
+	CreatedAfter *strfmt.DateTime `json:"created_after,omitempty"`
+
+	// Only return backups created before this time.
+	CreatedBefore *strfmt.DateTime `json:"created_before,omitempty"`
+
+	// The order of the returned backups.
+	Sort *string `json:"sort,omitempty"`
+
+	// The maximum number of backups to return per page.
+	Limit *int64 `json:"limit,omitempty"`
+
+	// A server-provided token determining which page of backups to return.
+	Start *string `json:"start,omitempty"`
+
 	// Allows users to set headers on API requests.
 	Headers map[string]string
 }
 
+// Constants associated with the GetDb2SaasBackupOptions.Type property.
+// Only return backups of this type.
+const (
+	GetDb2SaasBackupOptions_Type_OnDemand = "on_demand"
+	GetDb2SaasBackupOptions_Type_Scheduled = "scheduled"
+)
+
+// Constants associated with the GetDb2SaasBackupOptions.Sort property.
+// The order of the returned backups.
+const (
+	GetDb2SaasBackupOptions_Sort_CreatedAt = "created_at"
+	GetDb2SaasBackupOptions_Sort_CreatedAtDesc = "-created_at"
+)
+
 // NewGetDb2SaasBackupOptions : Instantiate GetDb2SaasBackupOptions
 func (*Db2saasV1) NewGetDb2SaasBackupOptions(xDbProfile string) *GetDb2SaasBackupOptions {
 	return &GetDb2SaasBackupOptions{
@@ -3781,6 +4150,48 @@ func (_options *GetDb2SaasBackupOptions) SetXDbProfile(xDbProfile string) *GetDb
 	return _options
 }
 
+// SetType : Allow user to set Type
+func (_options *GetDb2SaasBackupOptions) SetType(typeVar string) *GetDb2SaasBackupOptions {
+	_options.Type = core.StringPtr(typeVar)
+	return _options
+}
+
+// SetStatus : Allow user to set Status
+func (_options *GetDb2SaasBackupOptions) SetStatus(status string) *GetDb2SaasBackupOptions {
+	_options.Status = core.StringPtr(status)
+	return _options
+}
+
+// SetCreatedAfter : Allow user to set CreatedAfter
+func (_options *GetDb2SaasBackupOptions) SetCreatedAfter(createdAfter *strfmt.DateTime) *GetDb2SaasBackupOptions {
+	_options.CreatedAfter = createdAfter
+	return _options
+}
+
+// SetCreatedBefore : Allow user to set CreatedBefore
+func (_options *GetDb2SaasBackupOptions) SetCreatedBefore(createdBefore *strfmt.DateTime) *GetDb2SaasBackupOptions {
+	_options.CreatedBefore = createdBefore
+	return _options
+}
+
+// SetSort : Allow user to set Sort
+func (_options *GetDb2SaasBackupOptions) SetSort(sort string) *GetDb2SaasBackupOptions {
+	_options.Sort = core.StringPtr(sort)
+	return _options
+}
+
+// SetLimit : Allow user to set Limit
+func (_options *GetDb2SaasBackupOptions) SetLimit(limit int64) *GetDb2SaasBackupOptions {
+	_options.Limit = core.Int64Ptr(limit)
+	return _options
+}
+
+// SetStart : Allow user to set Start
+func (_options *GetDb2SaasBackupOptions) SetStart(start string) *GetDb2SaasBackupOptions {
+	_options.Start = core.StringPtr(start)
+	return _options
+}
+
 // SetHeaders : Allow user to set Headers
 func (options *GetDb2SaasBackupOptions) SetHeaders(param map[string]string) *GetDb2SaasBackupOptions {
 	options.Headers = param
@@ -3825,8 +4236,38 @@ func (options *GetDb2SaasConnectionInfoOptions) SetHeaders(param map[string]stri
 	return options
 }
 
+// GetDb2SaasRestoreOptions : The GetDb2SaasRestore options.
+type GetDb2SaasRestoreOptions struct {
+	// Encoded CRN deployment id.
+	XDbProfile *string `json:"x-db-profile" validate:"required"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewGetDb2SaasRestoreOptions : Instantiate GetDb2SaasRestoreOptions
+func (*Db2saasV1) NewGetDb2SaasRestoreOptions(xDbProfile string) *GetDb2SaasRestoreOptions {
+	return &GetDb2SaasRestoreOptions{
+		XDbProfile: core.StringPtr(xDbProfile),
+	}
+}
+
+// SetXDbProfile : Allow user to set XDbProfile
+func (_options *GetDb2SaasRestoreOptions) SetXDbProfile(xDbProfile string) *GetDb2SaasRestoreOptions {
+	_options.XDbProfile = core.StringPtr(xDbProfile)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetDb2SaasRestoreOptions) SetHeaders(param map[string]string) *GetDb2SaasRestoreOptions {
+	options.Headers = param
+	return options
+}
+
 // GetDb2SaasTuneableParamOptions : The GetDb2SaasTuneableParam options.
 type GetDb2SaasTuneableParamOptions struct {
+	// Encoded CRN deployment id.
+	XDbProfile *string `json:"x-db-profile,omitempty"`
 
 	// Allows users to set headers on API requests.
 	Headers map[string]string
@@ -3837,6 +4278,12 @@ func (*Db2saasV1) NewGetDb2SaasTuneableParamOptions() *GetDb2SaasTuneableParamOp
 	return &GetDb2SaasTuneableParamOptions{}
 }
 
+// SetXDbProfile : Allow user to set XDbProfile
+func (_options *GetDb2SaasTuneableParamOptions) SetXDbProfile(xDbProfile string) *GetDb2SaasTuneableParamOptions {
+	_options.XDbProfile = core.StringPtr(xDbProfile)
+	return _options
+}
+
 // SetHeaders : Allow user to set Headers
 func (options *GetDb2SaasTuneableParamOptions) SetHeaders(param map[string]string) *GetDb2SaasTuneableParamOptions {
 	options.Headers = param
@@ -3948,6 +4395,24 @@ func UnmarshalIpAddress(m map[string]json.RawMessage, result interface{}) (err e
 	return
 }
 
+// PaginationLink : A link to a page of results.
+type PaginationLink struct {
+	// The URL of the page.
+	Href *string `json:"href" validate:"required"`
+}
+
+// UnmarshalPaginationLink unmarshals an instance of PaginationLink from the specified map of raw messages.
+func UnmarshalPaginationLink(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(PaginationLink)
+	err = core.UnmarshalPrimitive(m, "href", &obj.Href)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "href-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
 // PostDb2SaasAllowlistOptions : The PostDb2SaasAllowlist options.
 type PostDb2SaasAllowlistOptions struct {
 	// CRN deployment id.
@@ -4069,6 +4534,53 @@ func (options *PostDb2SaasDbConfigurationOptions) SetHeaders(param map[string]st
 	return options
 }
 
+// PostDb2SaasRestoreOptions : The PostDb2SaasRestore options.
+type PostDb2SaasRestoreOptions struct {
+	// Encoded CRN deployment id.
+	XDbProfile *string `json:"x-db-profile" validate:"required"`
+
+	// The id of the backup to restore from. Exactly one of BackupID and TargetTime must be set.
+	BackupID *string `json:"backup_id,omitempty"`
+
+	// Point in time to restore the instance to, as an RFC 3339 timestamp. The service picks the backup preceding
+	// it. Exactly one of BackupID and TargetTime must be set.
+	TargetTime *string `json:"target_time,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewPostDb2SaasRestoreOptions : Instantiate PostDb2SaasRestoreOptions
+func (*Db2saasV1) NewPostDb2SaasRestoreOptions(xDbProfile string) *PostDb2SaasRestoreOptions {
+	return &PostDb2SaasRestoreOptions{
+		XDbProfile: core.StringPtr(xDbProfile),
+	}
+}
+
+// SetXDbProfile : Allow user to set XDbProfile
+func (_options *PostDb2SaasRestoreOptions) SetXDbProfile(xDbProfile string) *PostDb2SaasRestoreOptions {
+	_options.XDbProfile = core.StringPtr(xDbProfile)
+	return _options
+}
+
+// SetBackupID : Allow user to set BackupID
+func (_options *PostDb2SaasRestoreOptions) SetBackupID(backupID string) *PostDb2SaasRestoreOptions {
+	_options.BackupID = core.StringPtr(backupID)
+	return _options
+}
+
+// SetTargetTime : Allow user to set TargetTime
+func (_options *PostDb2SaasRestoreOptions) SetTargetTime(targetTime string) *PostDb2SaasRestoreOptions {
+	_options.TargetTime = core.StringPtr(targetTime)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *PostDb2SaasRestoreOptions) SetHeaders(param map[string]string) *PostDb2SaasRestoreOptions {
+	options.Headers = param
+	return options
+}
+
 // PostDb2SaasUserOptions : The PostDb2SaasUser options.
 type PostDb2SaasUserOptions struct {
 	// CRN deployment id.
@@ -4428,6 +4940,56 @@ func (options *PutDb2SaasUserOptions) SetHeaders(param map[string]string) *PutDb
 	return options
 }
 
+// Restore : Info of restore.
+type Restore struct {
+	// Id of the restore task.
+	ID *string `json:"id" validate:"required"`
+
+	// Id of the backup the instance is restored from.
+	BackupID *string `json:"backup_id,omitempty"`
+
+	// Point in time the instance is restored to.
+	TargetTime *string `json:"target_time,omitempty"`
+
+	// Status of the restore.
+	Status *string `json:"status" validate:"required"`
+
+	// Timestamp of the restore created.
+	CreatedAt *string `json:"created_at" validate:"required"`
+}
+
+// UnmarshalRestore unmarshals an instance of Restore from the specified map of raw messages.
+func UnmarshalRestore(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(Restore)
+	err = core.UnmarshalPrimitive(m, "id", &obj.ID)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "backup_id", &obj.BackupID)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "backup_id-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "target_time", &obj.TargetTime)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "target_time-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "status", &obj.Status)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "status-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "created_at", &obj.CreatedAt)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "created_at-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
 // SuccessAutoScaling : The details of the autoscale.
 type SuccessAutoScaling struct {
 	// Indicates the maximum number of scaling actions that are allowed within a specified time period.
@@ -4687,6 +5249,41 @@ func UnmarshalSuccessCreateBackupTask(m map[string]json.RawMessage, result inter
 	return
 }
 
+// SuccessCreateRestore : Success response of post restore.
+type SuccessCreateRestore struct {
+	Task *SuccessCreateRestoreTask `json:"task" validate:"required"`
+}
+
+// UnmarshalSuccessCreateRestore unmarshals an instance of SuccessCreateRestore from the specified map of raw messages.
+func UnmarshalSuccessCreateRestore(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(SuccessCreateRestore)
+	err = core.UnmarshalModel(m, "task", &obj.Task, UnmarshalSuccessCreateRestoreTask)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "task-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// SuccessCreateRestoreTask : SuccessCreateRestoreTask struct
+type SuccessCreateRestoreTask struct {
+	// Id of the restore task.
+	ID *string `json:"id,omitempty"`
+}
+
+// UnmarshalSuccessCreateRestoreTask unmarshals an instance of SuccessCreateRestoreTask from the specified map of raw messages.
+func UnmarshalSuccessCreateRestoreTask(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(SuccessCreateRestoreTask)
+	err = core.UnmarshalPrimitive(m, "id", &obj.ID)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
 // SuccessGetAllowlistIPs : Success response of get allowlist IPs.
 type SuccessGetAllowlistIPs struct {
 	// List of IP addresses.
@@ -4708,6 +5305,15 @@ func UnmarshalSuccessGetAllowlistIPs(m map[string]json.RawMessage, result interf
 // SuccessGetBackups : The details of the backups.
 type SuccessGetBackups struct {
 	Backups []Backup `json:"backups" validate:"required"`
+
+	// The maximum number of backups returned per page.
+	Limit *int64 `json:"limit,omitempty"`
+
+	// The total number of backups matching the request.
+	TotalCount *int64 `json:"total_count,omitempty"`
+
+	// A link to the next page of backups; absent on the last page.
+	Next *PaginationLink `json:"next,omitempty"`
 }
 
 // UnmarshalSuccessGetBackups unmarshals an instance of SuccessGetBackups from the specified map of raw messages.
@@ -4718,6 +5324,53 @@ func UnmarshalSuccessGetBackups(m map[string]json.RawMessage, result interface{}
 		err = core.SDKErrorf(err, "", "backups-error", common.GetComponentInfo())
 		return
 	}
+	err = core.UnmarshalPrimitive(m, "limit", &obj.Limit)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "limit-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "total_count", &obj.TotalCount)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "total_count-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalModel(m, "next", &obj.Next, UnmarshalPaginationLink)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "next-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *SuccessGetBackups) GetNextStart() (*string, error) {
+	if core.IsNil(resp.Next) {
+		return nil, nil
+	}
+	start, err := core.GetQueryParam(resp.Next.Href, "start")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-query-param-error", common.GetComponentInfo())
+		return nil, err
+	} else if start == nil {
+		return nil, nil
+	}
+	return start, nil
+}
+
+// SuccessGetRestores : The details of the restores.
+type SuccessGetRestores struct {
+	Restores []Restore `json:"restores" validate:"required"`
+}
+
+// UnmarshalSuccessGetRestores unmarshals an instance of SuccessGetRestores from the specified map of raw messages.
+func UnmarshalSuccessGetRestores(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(SuccessGetRestores)
+	err = core.UnmarshalModel(m, "restores", &obj.Restores, UnmarshalRestore)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "restores-error", common.GetComponentInfo())
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -6605,3 +7258,96 @@ func UnmarshalUpdateUserAuthentication(m map[string]json.RawMessage, result inte
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+//
+// GetDb2SaasBackupPager can be used to simplify the use of the "GetDb2SaasBackup" method.
+//
+type GetDb2SaasBackupPager struct {
+	hasNext     bool
+	options     *GetDb2SaasBackupOptions
+	client      *Db2saasV1
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewGetDb2SaasBackupPager returns a new GetDb2SaasBackupPager instance.
+func (db2saas *Db2saasV1) NewGetDb2SaasBackupPager(options *GetDb2SaasBackupOptions) (pager *GetDb2SaasBackupPager, err error) {
+	if options.Start != nil && *options.Start != "" {
+		err = core.SDKErrorf(nil, "the 'options.Start' field should not be set", "no-query-setting", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy GetDb2SaasBackupOptions = *options
+	pager = &GetDb2SaasBackupPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  db2saas,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *GetDb2SaasBackupPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *GetDb2SaasBackupPager) GetNextWithContext(ctx context.Context) (page []Backup, err error) {
+	if !pager.HasNext() {
+		return nil, core.SDKErrorf(nil, "no more results available", "no-more-results", common.GetComponentInfo())
+	}
+
+	pager.options.Start = pager.pageContext.next
+
+	result, _, err := pager.client.GetDb2SaasBackupWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+	if result == nil {
+		pager.hasNext = false
+		return
+	}
+
+	var next *string
+	next, err = result.GetNextStart()
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-next-start-error")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Backups
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *GetDb2SaasBackupPager) GetAllWithContext(ctx context.Context) (allItems []Backup, err error) {
+	for pager.HasNext() {
+		var nextPage []Backup
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *GetDb2SaasBackupPager) GetNext() (page []Backup, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *GetDb2SaasBackupPager) GetAll() (allItems []Backup, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
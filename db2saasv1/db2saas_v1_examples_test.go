@@ -410,6 +410,49 @@ var _ = Describe(`Db2saasV1 Examples Tests`, func() {
 			Expect(response.StatusCode).To(Equal(200))
 			Expect(successCreateBackup).ToNot(BeNil())
 		})
+		It(`PostDb2SaasRestore request example`, func() {
+			fmt.Println("\nPostDb2SaasRestore() result:")
+			// begin-post_db2_saas_restore
+
+			postDb2SaasRestoreOptions := db2saasService.NewPostDb2SaasRestoreOptions(
+				"crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A",
+			)
+			postDb2SaasRestoreOptions.SetTargetTime("2025-01-01T00:00:00Z")
+
+			successCreateRestore, response, err := db2saasService.PostDb2SaasRestore(postDb2SaasRestoreOptions)
+			if err != nil {
+				panic(err)
+			}
+			b, _ := json.MarshalIndent(successCreateRestore, "", "  ")
+			fmt.Println(string(b))
+
+			// end-post_db2_saas_restore
+
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(200))
+			Expect(successCreateRestore).ToNot(BeNil())
+		})
+		It(`GetDb2SaasRestore request example`, func() {
+			fmt.Println("\nGetDb2SaasRestore() result:")
+			// begin-get_db2_saas_restore
+
+			getDb2SaasRestoreOptions := db2saasService.NewGetDb2SaasRestoreOptions(
+				"crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A",
+			)
+
+			successGetRestores, response, err := db2saasService.GetDb2SaasRestore(getDb2SaasRestoreOptions)
+			if err != nil {
+				panic(err)
+			}
+			b, _ := json.MarshalIndent(successGetRestores, "", "  ")
+			fmt.Println(string(b))
+
+			// end-get_db2_saas_restore
+
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(200))
+			Expect(successGetRestores).ToNot(BeNil())
+		})
 		It(`DeleteDb2SaasUser request example`, func() {
 			// begin-delete_db2_saas_user
 
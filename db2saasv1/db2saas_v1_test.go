@@ -21,6 +21,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -163,6 +164,90 @@ var _ = Describe(`Db2saasV1`, func() {
 			Expect(url).To(BeEmpty())
 			Expect(err).ToNot(BeNil())
 			fmt.Fprintf(GinkgoWriter, "Expected error: %s\n", err.Error())
+
+			var regionErr *db2saasv1.UnknownRegionError
+			Expect(errors.As(err, &regionErr)).To(BeTrue())
+			Expect(regionErr.Region).To(Equal("INVALID_REGION"))
+		})
+		It(`GetServiceURLForRegion(region string) with known regions`, func() {
+			for _, region := range []string{"us-south", "eu-de", "jp-tok"} {
+				url, err := db2saasv1.GetServiceURLForRegion(region)
+				Expect(err).To(BeNil())
+				Expect(url).To(Equal("https://" + region + ".db2.saas.ibm.com/dbapi/v4"))
+			}
+		})
+		It(`Instantiate service client with a region`, func() {
+			db2saasService, serviceErr := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+				Authenticator: &core.NoAuthAuthenticator{},
+				Region:        "eu-de",
+			})
+			Expect(serviceErr).To(BeNil())
+			Expect(db2saasService.GetServiceURL()).To(Equal("https://eu-de.db2.saas.ibm.com/dbapi/v4"))
+		})
+		It(`Instantiate service client with a region and a URL`, func() {
+			db2saasService, serviceErr := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+				Authenticator: &core.NoAuthAuthenticator{},
+				URL:           "https://testService/api",
+				Region:        "eu-de",
+			})
+			Expect(serviceErr).To(BeNil())
+			Expect(db2saasService.GetServiceURL()).To(Equal("https://testService/api"))
+		})
+		It(`Instantiate service client with error: Unknown region`, func() {
+			db2saasService, serviceErr := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+				Authenticator: &core.NoAuthAuthenticator{},
+				Region:        "mars-north",
+			})
+			Expect(db2saasService).To(BeNil())
+			Expect(serviceErr).ToNot(BeNil())
+
+			var regionErr *db2saasv1.UnknownRegionError
+			Expect(errors.As(serviceErr, &regionErr)).To(BeTrue())
+		})
+		It(`Create service client using external config with a region`, func() {
+			var testEnvironment = map[string]string{
+				"DB2SAAS_REGION":    "jp-tok",
+				"DB2SAAS_AUTH_TYPE": "noauth",
+			}
+			SetTestEnvironment(testEnvironment)
+			defer ClearTestEnvironment(testEnvironment)
+
+			db2saasService, serviceErr := db2saasv1.NewDb2saasV1UsingExternalConfig(&db2saasv1.Db2saasV1Options{})
+			Expect(serviceErr).To(BeNil())
+			Expect(db2saasService.GetServiceURL()).To(Equal("https://jp-tok.db2.saas.ibm.com/dbapi/v4"))
+
+			db2saasService, serviceErr = db2saasv1.NewDb2saasV1UsingExternalConfig(&db2saasv1.Db2saasV1Options{
+				Region: "eu-de",
+			})
+			Expect(serviceErr).To(BeNil())
+			Expect(db2saasService.GetServiceURL()).To(Equal("https://eu-de.db2.saas.ibm.com/dbapi/v4"))
+		})
+		It(`Create service client using external config with a region and a URL`, func() {
+			var testEnvironment = map[string]string{
+				"DB2SAAS_URL":       "https://db2saasv1/api",
+				"DB2SAAS_REGION":    "jp-tok",
+				"DB2SAAS_AUTH_TYPE": "noauth",
+			}
+			SetTestEnvironment(testEnvironment)
+			defer ClearTestEnvironment(testEnvironment)
+
+			db2saasService, serviceErr := db2saasv1.NewDb2saasV1UsingExternalConfig(&db2saasv1.Db2saasV1Options{})
+			Expect(serviceErr).To(BeNil())
+			Expect(db2saasService.GetServiceURL()).To(Equal("https://db2saasv1/api"))
+		})
+		It(`Create service client using external config with error: Unknown region`, func() {
+			var testEnvironment = map[string]string{
+				"DB2SAAS_REGION":    "mars-north",
+				"DB2SAAS_AUTH_TYPE": "noauth",
+			}
+			SetTestEnvironment(testEnvironment)
+			defer ClearTestEnvironment(testEnvironment)
+
+			_, serviceErr := db2saasv1.NewDb2saasV1UsingExternalConfig(&db2saasv1.Db2saasV1Options{})
+			Expect(serviceErr).ToNot(BeNil())
+
+			var regionErr *db2saasv1.UnknownRegionError
+			Expect(errors.As(serviceErr, &regionErr)).To(BeTrue())
 		})
 	})
 	Describe(`Parameterized URL tests`, func() {
@@ -3885,6 +3970,13 @@ var _ = Describe(`Db2saasV1`, func() {
 					Expect(req.Method).To(Equal("GET"))
 					Expect(req.Header["X-Db-Profile"]).ToNot(BeNil())
 					Expect(req.Header["X-Db-Profile"][0]).To(Equal(fmt.Sprintf("%v", "crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")))
+					Expect(req.URL.Query()["type"]).To(Equal([]string{"scheduled"}))
+					Expect(req.URL.Query()["status"]).To(Equal([]string{"completed"}))
+					Expect(req.URL.Query()["created_after"]).To(Equal([]string{"2019-01-01T12:00:00.000Z"}))
+					Expect(req.URL.Query()["created_before"]).To(Equal([]string{"2019-01-01T12:00:00.000Z"}))
+					Expect(req.URL.Query()["sort"]).To(Equal([]string{"-created_at"}))
+					Expect(req.URL.Query()["limit"]).To(Equal([]string{fmt.Sprint(int64(10))}))
+					Expect(req.URL.Query()["start"]).To(Equal([]string{"testString"}))
 					res.Header().Set("Content-type", "application/json")
 					res.WriteHeader(200)
 					fmt.Fprint(res, `} this is not valid json {`)
@@ -3901,6 +3993,13 @@ var _ = Describe(`Db2saasV1`, func() {
 				// Construct an instance of the GetDb2SaasBackupOptions model
 				getDb2SaasBackupOptionsModel := new(db2saasv1.GetDb2SaasBackupOptions)
 				getDb2SaasBackupOptionsModel.XDbProfile = core.StringPtr("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")
+				getDb2SaasBackupOptionsModel.Type = core.StringPtr("scheduled")
+				getDb2SaasBackupOptionsModel.Status = core.StringPtr("completed")
+				getDb2SaasBackupOptionsModel.CreatedAfter = CreateMockDateTime("2019-01-01T12:00:00.000Z")
+				getDb2SaasBackupOptionsModel.CreatedBefore = CreateMockDateTime("2019-01-01T12:00:00.000Z")
+				getDb2SaasBackupOptionsModel.Sort = core.StringPtr("-created_at")
+				getDb2SaasBackupOptionsModel.Limit = core.Int64Ptr(int64(10))
+				getDb2SaasBackupOptionsModel.Start = core.StringPtr("testString")
 				getDb2SaasBackupOptionsModel.Headers = map[string]string{"x-custom-header": "x-custom-value"}
 				// Expect response parsing to fail since we are receiving a text/plain response
 				result, response, operationErr := db2saasService.GetDb2SaasBackup(getDb2SaasBackupOptionsModel)
@@ -3933,6 +4032,13 @@ var _ = Describe(`Db2saasV1`, func() {
 
 					Expect(req.Header["X-Db-Profile"]).ToNot(BeNil())
 					Expect(req.Header["X-Db-Profile"][0]).To(Equal(fmt.Sprintf("%v", "crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")))
+					Expect(req.URL.Query()["type"]).To(Equal([]string{"scheduled"}))
+					Expect(req.URL.Query()["status"]).To(Equal([]string{"completed"}))
+					Expect(req.URL.Query()["created_after"]).To(Equal([]string{"2019-01-01T12:00:00.000Z"}))
+					Expect(req.URL.Query()["created_before"]).To(Equal([]string{"2019-01-01T12:00:00.000Z"}))
+					Expect(req.URL.Query()["sort"]).To(Equal([]string{"-created_at"}))
+					Expect(req.URL.Query()["limit"]).To(Equal([]string{fmt.Sprint(int64(10))}))
+					Expect(req.URL.Query()["start"]).To(Equal([]string{"testString"}))
 					// Sleep a short time to support a timeout test
 					time.Sleep(100 * time.Millisecond)
 
@@ -3954,6 +4060,13 @@ var _ = Describe(`Db2saasV1`, func() {
 				// Construct an instance of the GetDb2SaasBackupOptions model
 				getDb2SaasBackupOptionsModel := new(db2saasv1.GetDb2SaasBackupOptions)
 				getDb2SaasBackupOptionsModel.XDbProfile = core.StringPtr("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")
+				getDb2SaasBackupOptionsModel.Type = core.StringPtr("scheduled")
+				getDb2SaasBackupOptionsModel.Status = core.StringPtr("completed")
+				getDb2SaasBackupOptionsModel.CreatedAfter = CreateMockDateTime("2019-01-01T12:00:00.000Z")
+				getDb2SaasBackupOptionsModel.CreatedBefore = CreateMockDateTime("2019-01-01T12:00:00.000Z")
+				getDb2SaasBackupOptionsModel.Sort = core.StringPtr("-created_at")
+				getDb2SaasBackupOptionsModel.Limit = core.Int64Ptr(int64(10))
+				getDb2SaasBackupOptionsModel.Start = core.StringPtr("testString")
 				getDb2SaasBackupOptionsModel.Headers = map[string]string{"x-custom-header": "x-custom-value"}
 
 				// Invoke operation with a Context to test a timeout error
@@ -3992,6 +4105,13 @@ var _ = Describe(`Db2saasV1`, func() {
 
 					Expect(req.Header["X-Db-Profile"]).ToNot(BeNil())
 					Expect(req.Header["X-Db-Profile"][0]).To(Equal(fmt.Sprintf("%v", "crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")))
+					Expect(req.URL.Query()["type"]).To(Equal([]string{"scheduled"}))
+					Expect(req.URL.Query()["status"]).To(Equal([]string{"completed"}))
+					Expect(req.URL.Query()["created_after"]).To(Equal([]string{"2019-01-01T12:00:00.000Z"}))
+					Expect(req.URL.Query()["created_before"]).To(Equal([]string{"2019-01-01T12:00:00.000Z"}))
+					Expect(req.URL.Query()["sort"]).To(Equal([]string{"-created_at"}))
+					Expect(req.URL.Query()["limit"]).To(Equal([]string{fmt.Sprint(int64(10))}))
+					Expect(req.URL.Query()["start"]).To(Equal([]string{"testString"}))
 					// Set mock response
 					res.Header().Set("Content-type", "application/json")
 					res.WriteHeader(200)
@@ -4015,6 +4135,13 @@ var _ = Describe(`Db2saasV1`, func() {
 				// Construct an instance of the GetDb2SaasBackupOptions model
 				getDb2SaasBackupOptionsModel := new(db2saasv1.GetDb2SaasBackupOptions)
 				getDb2SaasBackupOptionsModel.XDbProfile = core.StringPtr("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")
+				getDb2SaasBackupOptionsModel.Type = core.StringPtr("scheduled")
+				getDb2SaasBackupOptionsModel.Status = core.StringPtr("completed")
+				getDb2SaasBackupOptionsModel.CreatedAfter = CreateMockDateTime("2019-01-01T12:00:00.000Z")
+				getDb2SaasBackupOptionsModel.CreatedBefore = CreateMockDateTime("2019-01-01T12:00:00.000Z")
+				getDb2SaasBackupOptionsModel.Sort = core.StringPtr("-created_at")
+				getDb2SaasBackupOptionsModel.Limit = core.Int64Ptr(int64(10))
+				getDb2SaasBackupOptionsModel.Start = core.StringPtr("testString")
 				getDb2SaasBackupOptionsModel.Headers = map[string]string{"x-custom-header": "x-custom-value"}
 
 				// Invoke operation with valid options model (positive test)
@@ -4035,6 +4162,13 @@ var _ = Describe(`Db2saasV1`, func() {
 				// Construct an instance of the GetDb2SaasBackupOptions model
 				getDb2SaasBackupOptionsModel := new(db2saasv1.GetDb2SaasBackupOptions)
 				getDb2SaasBackupOptionsModel.XDbProfile = core.StringPtr("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")
+				getDb2SaasBackupOptionsModel.Type = core.StringPtr("scheduled")
+				getDb2SaasBackupOptionsModel.Status = core.StringPtr("completed")
+				getDb2SaasBackupOptionsModel.CreatedAfter = CreateMockDateTime("2019-01-01T12:00:00.000Z")
+				getDb2SaasBackupOptionsModel.CreatedBefore = CreateMockDateTime("2019-01-01T12:00:00.000Z")
+				getDb2SaasBackupOptionsModel.Sort = core.StringPtr("-created_at")
+				getDb2SaasBackupOptionsModel.Limit = core.Int64Ptr(int64(10))
+				getDb2SaasBackupOptionsModel.Start = core.StringPtr("testString")
 				getDb2SaasBackupOptionsModel.Headers = map[string]string{"x-custom-header": "x-custom-value"}
 				// Invoke operation with empty URL (negative test)
 				err := db2saasService.SetServiceURL("")
@@ -4076,6 +4210,13 @@ var _ = Describe(`Db2saasV1`, func() {
 				// Construct an instance of the GetDb2SaasBackupOptions model
 				getDb2SaasBackupOptionsModel := new(db2saasv1.GetDb2SaasBackupOptions)
 				getDb2SaasBackupOptionsModel.XDbProfile = core.StringPtr("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")
+				getDb2SaasBackupOptionsModel.Type = core.StringPtr("scheduled")
+				getDb2SaasBackupOptionsModel.Status = core.StringPtr("completed")
+				getDb2SaasBackupOptionsModel.CreatedAfter = CreateMockDateTime("2019-01-01T12:00:00.000Z")
+				getDb2SaasBackupOptionsModel.CreatedBefore = CreateMockDateTime("2019-01-01T12:00:00.000Z")
+				getDb2SaasBackupOptionsModel.Sort = core.StringPtr("-created_at")
+				getDb2SaasBackupOptionsModel.Limit = core.Int64Ptr(int64(10))
+				getDb2SaasBackupOptionsModel.Start = core.StringPtr("testString")
 				getDb2SaasBackupOptionsModel.Headers = map[string]string{"x-custom-header": "x-custom-value"}
 
 				// Invoke operation
@@ -4090,6 +4231,119 @@ var _ = Describe(`Db2saasV1`, func() {
 				testServer.Close()
 			})
 		})
+		It(`Test pagination helper method on response`, func() {
+			responseObject := new(db2saasv1.SuccessGetBackups)
+			nextObject := new(db2saasv1.PaginationLink)
+			nextObject.Href = core.StringPtr("ibm.com?start=abc-123")
+			responseObject.Next = nextObject
+
+			value, err := responseObject.GetNextStart()
+			Expect(err).To(BeNil())
+			Expect(value).To(Equal(core.StringPtr("abc-123")))
+		})
+		It(`Test pagination helper method on response with empty URL`, func() {
+			responseObject := new(db2saasv1.SuccessGetBackups)
+
+			value, err := responseObject.GetNextStart()
+			Expect(err).To(BeNil())
+			Expect(value).To(BeNil())
+		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(getDb2SaasBackupPath))
+					Expect(req.Method).To(Equal("GET"))
+					requestNumber++
+					if requestNumber == 1 {
+						res.Header().Set("Content-type", "application/json")
+						res.WriteHeader(200)
+						fmt.Fprintf(res, "%s", `{"next":{"href":"https://myhost.com/somePath?start=1"},"total_count":2,"limit":1,"backups":[{"id":"ID","type":"Type","status":"Status","created_at":"CreatedAt","size":4,"duration":8}]}`)
+					} else if requestNumber == 2 {
+						res.Header().Set("Content-type", "application/json")
+						res.WriteHeader(200)
+						fmt.Fprintf(res, "%s", `{"total_count":2,"limit":1,"backups":[{"id":"ID","type":"Type","status":"Status","created_at":"CreatedAt","size":4,"duration":8}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use GetDb2SaasBackupPager.GetNext successfully`, func() {
+				db2saasService, serviceErr := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(db2saasService).ToNot(BeNil())
+
+				getDb2SaasBackupOptionsModel := &db2saasv1.GetDb2SaasBackupOptions{
+					XDbProfile: core.StringPtr("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A"),
+					Type:       core.StringPtr("scheduled"),
+					Status:     core.StringPtr("completed"),
+					Sort:       core.StringPtr("-created_at"),
+					Limit:      core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := db2saasService.NewGetDb2SaasBackupPager(getDb2SaasBackupOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []db2saasv1.Backup
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+
+				_, err = pager.GetNext()
+				Expect(err).ToNot(BeNil())
+			})
+			It(`Use GetDb2SaasBackupPager.GetAll successfully`, func() {
+				db2saasService, serviceErr := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(db2saasService).ToNot(BeNil())
+
+				getDb2SaasBackupOptionsModel := &db2saasv1.GetDb2SaasBackupOptions{
+					XDbProfile: core.StringPtr("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A"),
+					Type:       core.StringPtr("scheduled"),
+					Status:     core.StringPtr("completed"),
+					Sort:       core.StringPtr("-created_at"),
+					Limit:      core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := db2saasService.NewGetDb2SaasBackupPager(getDb2SaasBackupOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Invoke NewGetDb2SaasBackupPager with error: Start already set`, func() {
+				db2saasService, serviceErr := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+
+				getDb2SaasBackupOptionsModel := db2saasService.NewGetDb2SaasBackupOptions("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A").SetStart("1")
+				pager, err := db2saasService.NewGetDb2SaasBackupPager(getDb2SaasBackupOptionsModel)
+				Expect(err).ToNot(BeNil())
+				Expect(pager).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
 	})
 	Describe(`PostDb2SaasBackup(postDb2SaasBackupOptions *PostDb2SaasBackupOptions) - Operation response error`, func() {
 		postDb2SaasBackupPath := "/manage/backups/backup"
@@ -4309,6 +4563,447 @@ var _ = Describe(`Db2saasV1`, func() {
 			})
 		})
 	})
+	Describe(`GetDb2SaasRestore(getDb2SaasRestoreOptions *GetDb2SaasRestoreOptions) - Operation response error`, func() {
+		getDb2SaasRestorePath := "/manage/backups/restore"
+		Context(`Using mock server endpoint with invalid JSON response`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(getDb2SaasRestorePath))
+					Expect(req.Method).To(Equal("GET"))
+					Expect(req.Header["X-Db-Profile"]).ToNot(BeNil())
+					Expect(req.Header["X-Db-Profile"][0]).To(Equal(fmt.Sprintf("%v", "crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")))
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					fmt.Fprint(res, `} this is not valid json {`)
+				}))
+			})
+			It(`Invoke GetDb2SaasRestore with error: Operation response processing error`, func() {
+				db2saasService, serviceErr := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(db2saasService).ToNot(BeNil())
+
+				// Construct an instance of the GetDb2SaasRestoreOptions model
+				getDb2SaasRestoreOptionsModel := new(db2saasv1.GetDb2SaasRestoreOptions)
+				getDb2SaasRestoreOptionsModel.XDbProfile = core.StringPtr("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")
+				getDb2SaasRestoreOptionsModel.Headers = map[string]string{"x-custom-header": "x-custom-value"}
+				// Expect response parsing to fail since we are receiving a text/plain response
+				result, response, operationErr := db2saasService.GetDb2SaasRestore(getDb2SaasRestoreOptionsModel)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).To(BeNil())
+
+				// Enable retries and test again
+				db2saasService.EnableRetries(0, 0)
+				result, response, operationErr = db2saasService.GetDb2SaasRestore(getDb2SaasRestoreOptionsModel)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`GetDb2SaasRestore(getDb2SaasRestoreOptions *GetDb2SaasRestoreOptions)`, func() {
+		getDb2SaasRestorePath := "/manage/backups/restore"
+		Context(`Using mock server endpoint with timeout`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(getDb2SaasRestorePath))
+					Expect(req.Method).To(Equal("GET"))
+
+					Expect(req.Header["X-Db-Profile"]).ToNot(BeNil())
+					Expect(req.Header["X-Db-Profile"][0]).To(Equal(fmt.Sprintf("%v", "crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")))
+					// Sleep a short time to support a timeout test
+					time.Sleep(100 * time.Millisecond)
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"restores": [{"id": "ID", "backup_id": "BackupID", "target_time": "TargetTime", "status": "Status", "created_at": "CreatedAt"}]}`)
+				}))
+			})
+			It(`Invoke GetDb2SaasRestore successfully with retries`, func() {
+				db2saasService, serviceErr := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(db2saasService).ToNot(BeNil())
+				db2saasService.EnableRetries(0, 0)
+
+				// Construct an instance of the GetDb2SaasRestoreOptions model
+				getDb2SaasRestoreOptionsModel := new(db2saasv1.GetDb2SaasRestoreOptions)
+				getDb2SaasRestoreOptionsModel.XDbProfile = core.StringPtr("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")
+				getDb2SaasRestoreOptionsModel.Headers = map[string]string{"x-custom-header": "x-custom-value"}
+
+				// Invoke operation with a Context to test a timeout error
+				ctx, cancelFunc := context.WithTimeout(context.Background(), 80*time.Millisecond)
+				defer cancelFunc()
+				_, _, operationErr := db2saasService.GetDb2SaasRestoreWithContext(ctx, getDb2SaasRestoreOptionsModel)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(operationErr.Error()).To(ContainSubstring("deadline exceeded"))
+
+				// Disable retries and test again
+				db2saasService.DisableRetries()
+				result, response, operationErr := db2saasService.GetDb2SaasRestore(getDb2SaasRestoreOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).ToNot(BeNil())
+
+				// Re-test the timeout error with retries disabled
+				ctx, cancelFunc2 := context.WithTimeout(context.Background(), 80*time.Millisecond)
+				defer cancelFunc2()
+				_, _, operationErr = db2saasService.GetDb2SaasRestoreWithContext(ctx, getDb2SaasRestoreOptionsModel)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(operationErr.Error()).To(ContainSubstring("deadline exceeded"))
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+		Context(`Using mock server endpoint`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(getDb2SaasRestorePath))
+					Expect(req.Method).To(Equal("GET"))
+
+					Expect(req.Header["X-Db-Profile"]).ToNot(BeNil())
+					Expect(req.Header["X-Db-Profile"][0]).To(Equal(fmt.Sprintf("%v", "crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")))
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"restores": [{"id": "ID", "backup_id": "BackupID", "target_time": "TargetTime", "status": "Status", "created_at": "CreatedAt"}]}`)
+				}))
+			})
+			It(`Invoke GetDb2SaasRestore successfully`, func() {
+				db2saasService, serviceErr := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(db2saasService).ToNot(BeNil())
+
+				// Invoke operation with nil options model (negative test)
+				result, response, operationErr := db2saasService.GetDb2SaasRestore(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+				Expect(result).To(BeNil())
+
+				// Construct an instance of the GetDb2SaasRestoreOptions model
+				getDb2SaasRestoreOptionsModel := new(db2saasv1.GetDb2SaasRestoreOptions)
+				getDb2SaasRestoreOptionsModel.XDbProfile = core.StringPtr("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")
+				getDb2SaasRestoreOptionsModel.Headers = map[string]string{"x-custom-header": "x-custom-value"}
+
+				// Invoke operation with valid options model (positive test)
+				result, response, operationErr = db2saasService.GetDb2SaasRestore(getDb2SaasRestoreOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).ToNot(BeNil())
+
+			})
+			It(`Invoke GetDb2SaasRestore with error: Operation validation and request error`, func() {
+				db2saasService, serviceErr := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(db2saasService).ToNot(BeNil())
+
+				// Construct an instance of the GetDb2SaasRestoreOptions model
+				getDb2SaasRestoreOptionsModel := new(db2saasv1.GetDb2SaasRestoreOptions)
+				getDb2SaasRestoreOptionsModel.XDbProfile = core.StringPtr("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")
+				getDb2SaasRestoreOptionsModel.Headers = map[string]string{"x-custom-header": "x-custom-value"}
+				// Invoke operation with empty URL (negative test)
+				err := db2saasService.SetServiceURL("")
+				Expect(err).To(BeNil())
+				result, response, operationErr := db2saasService.GetDb2SaasRestore(getDb2SaasRestoreOptionsModel)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(operationErr.Error()).To(ContainSubstring(core.ERRORMSG_SERVICE_URL_MISSING))
+				Expect(response).To(BeNil())
+				Expect(result).To(BeNil())
+				// Construct a second instance of the GetDb2SaasRestoreOptions model with no property values
+				getDb2SaasRestoreOptionsModelNew := new(db2saasv1.GetDb2SaasRestoreOptions)
+				// Invoke operation with invalid model (negative test)
+				result, response, operationErr = db2saasService.GetDb2SaasRestore(getDb2SaasRestoreOptionsModelNew)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).To(BeNil())
+				Expect(result).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+		Context(`Using mock server endpoint with missing response body`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Set success status code with no respoonse body
+					res.WriteHeader(200)
+				}))
+			})
+			It(`Invoke GetDb2SaasRestore successfully`, func() {
+				db2saasService, serviceErr := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(db2saasService).ToNot(BeNil())
+
+				// Construct an instance of the GetDb2SaasRestoreOptions model
+				getDb2SaasRestoreOptionsModel := new(db2saasv1.GetDb2SaasRestoreOptions)
+				getDb2SaasRestoreOptionsModel.XDbProfile = core.StringPtr("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")
+				getDb2SaasRestoreOptionsModel.Headers = map[string]string{"x-custom-header": "x-custom-value"}
+
+				// Invoke operation
+				result, response, operationErr := db2saasService.GetDb2SaasRestore(getDb2SaasRestoreOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+
+				// Verify a nil result
+				Expect(result).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`PostDb2SaasRestore(postDb2SaasRestoreOptions *PostDb2SaasRestoreOptions) - Operation response error`, func() {
+		postDb2SaasRestorePath := "/manage/backups/restore"
+		Context(`Using mock server endpoint with invalid JSON response`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(postDb2SaasRestorePath))
+					Expect(req.Method).To(Equal("POST"))
+					Expect(req.Header["X-Db-Profile"]).ToNot(BeNil())
+					Expect(req.Header["X-Db-Profile"][0]).To(Equal(fmt.Sprintf("%v", "crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")))
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					fmt.Fprint(res, `} this is not valid json {`)
+				}))
+			})
+			It(`Invoke PostDb2SaasRestore with error: Operation response processing error`, func() {
+				db2saasService, serviceErr := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(db2saasService).ToNot(BeNil())
+
+				// Construct an instance of the PostDb2SaasRestoreOptions model
+				postDb2SaasRestoreOptionsModel := new(db2saasv1.PostDb2SaasRestoreOptions)
+				postDb2SaasRestoreOptionsModel.XDbProfile = core.StringPtr("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")
+				postDb2SaasRestoreOptionsModel.BackupID = core.StringPtr("crn:v1:staging:public:dashdb-for-transactions:us-east:a/e7e3e87b512f474381c0684a5ecbba03:0c9c7889-54de-4ecc-8399-09a4d4ff228e:task:51ff2dc7-6cb9-41c0-9345-09e54550fb7b")
+				postDb2SaasRestoreOptionsModel.Headers = map[string]string{"x-custom-header": "x-custom-value"}
+				// Expect response parsing to fail since we are receiving a text/plain response
+				result, response, operationErr := db2saasService.PostDb2SaasRestore(postDb2SaasRestoreOptionsModel)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).To(BeNil())
+
+				// Enable retries and test again
+				db2saasService.EnableRetries(0, 0)
+				result, response, operationErr = db2saasService.PostDb2SaasRestore(postDb2SaasRestoreOptionsModel)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`PostDb2SaasRestore(postDb2SaasRestoreOptions *PostDb2SaasRestoreOptions)`, func() {
+		postDb2SaasRestorePath := "/manage/backups/restore"
+		Context(`Using mock server endpoint with timeout`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(postDb2SaasRestorePath))
+					Expect(req.Method).To(Equal("POST"))
+
+					Expect(req.Header["X-Db-Profile"]).ToNot(BeNil())
+					Expect(req.Header["X-Db-Profile"][0]).To(Equal(fmt.Sprintf("%v", "crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")))
+					// Sleep a short time to support a timeout test
+					time.Sleep(100 * time.Millisecond)
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"task": {"id": "crn:v1:staging:public:dashdb-for-transactions:us-east:a/e7e3e87b512f474381c0684a5ecbba03:0c9c7889-54de-4ecc-8399-09a4d4ff228e:task:8a3e5fe4-cd5d-4bd0-8d29-5e1d3b6e2a71"}}`)
+				}))
+			})
+			It(`Invoke PostDb2SaasRestore successfully with retries`, func() {
+				db2saasService, serviceErr := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(db2saasService).ToNot(BeNil())
+				db2saasService.EnableRetries(0, 0)
+
+				// Construct an instance of the PostDb2SaasRestoreOptions model
+				postDb2SaasRestoreOptionsModel := new(db2saasv1.PostDb2SaasRestoreOptions)
+				postDb2SaasRestoreOptionsModel.XDbProfile = core.StringPtr("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")
+				postDb2SaasRestoreOptionsModel.BackupID = core.StringPtr("crn:v1:staging:public:dashdb-for-transactions:us-east:a/e7e3e87b512f474381c0684a5ecbba03:0c9c7889-54de-4ecc-8399-09a4d4ff228e:task:51ff2dc7-6cb9-41c0-9345-09e54550fb7b")
+				postDb2SaasRestoreOptionsModel.Headers = map[string]string{"x-custom-header": "x-custom-value"}
+
+				// Invoke operation with a Context to test a timeout error
+				ctx, cancelFunc := context.WithTimeout(context.Background(), 80*time.Millisecond)
+				defer cancelFunc()
+				_, _, operationErr := db2saasService.PostDb2SaasRestoreWithContext(ctx, postDb2SaasRestoreOptionsModel)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(operationErr.Error()).To(ContainSubstring("deadline exceeded"))
+
+				// Disable retries and test again
+				db2saasService.DisableRetries()
+				result, response, operationErr := db2saasService.PostDb2SaasRestore(postDb2SaasRestoreOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).ToNot(BeNil())
+
+				// Re-test the timeout error with retries disabled
+				ctx, cancelFunc2 := context.WithTimeout(context.Background(), 80*time.Millisecond)
+				defer cancelFunc2()
+				_, _, operationErr = db2saasService.PostDb2SaasRestoreWithContext(ctx, postDb2SaasRestoreOptionsModel)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(operationErr.Error()).To(ContainSubstring("deadline exceeded"))
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+		Context(`Using mock server endpoint`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(postDb2SaasRestorePath))
+					Expect(req.Method).To(Equal("POST"))
+
+					Expect(req.Header["X-Db-Profile"]).ToNot(BeNil())
+					Expect(req.Header["X-Db-Profile"][0]).To(Equal(fmt.Sprintf("%v", "crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")))
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"task": {"id": "crn:v1:staging:public:dashdb-for-transactions:us-east:a/e7e3e87b512f474381c0684a5ecbba03:0c9c7889-54de-4ecc-8399-09a4d4ff228e:task:8a3e5fe4-cd5d-4bd0-8d29-5e1d3b6e2a71"}}`)
+				}))
+			})
+			It(`Invoke PostDb2SaasRestore successfully`, func() {
+				db2saasService, serviceErr := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(db2saasService).ToNot(BeNil())
+
+				// Invoke operation with nil options model (negative test)
+				result, response, operationErr := db2saasService.PostDb2SaasRestore(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(response).To(BeNil())
+				Expect(result).To(BeNil())
+
+				// Construct an instance of the PostDb2SaasRestoreOptions model
+				postDb2SaasRestoreOptionsModel := new(db2saasv1.PostDb2SaasRestoreOptions)
+				postDb2SaasRestoreOptionsModel.XDbProfile = core.StringPtr("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")
+				postDb2SaasRestoreOptionsModel.BackupID = core.StringPtr("crn:v1:staging:public:dashdb-for-transactions:us-east:a/e7e3e87b512f474381c0684a5ecbba03:0c9c7889-54de-4ecc-8399-09a4d4ff228e:task:51ff2dc7-6cb9-41c0-9345-09e54550fb7b")
+				postDb2SaasRestoreOptionsModel.Headers = map[string]string{"x-custom-header": "x-custom-value"}
+
+				// Invoke operation with valid options model (positive test)
+				result, response, operationErr = db2saasService.PostDb2SaasRestore(postDb2SaasRestoreOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(result).ToNot(BeNil())
+
+			})
+			It(`Invoke PostDb2SaasRestore with error: Operation validation and request error`, func() {
+				db2saasService, serviceErr := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(db2saasService).ToNot(BeNil())
+
+				// Construct an instance of the PostDb2SaasRestoreOptions model
+				postDb2SaasRestoreOptionsModel := new(db2saasv1.PostDb2SaasRestoreOptions)
+				postDb2SaasRestoreOptionsModel.XDbProfile = core.StringPtr("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")
+				postDb2SaasRestoreOptionsModel.BackupID = core.StringPtr("crn:v1:staging:public:dashdb-for-transactions:us-east:a/e7e3e87b512f474381c0684a5ecbba03:0c9c7889-54de-4ecc-8399-09a4d4ff228e:task:51ff2dc7-6cb9-41c0-9345-09e54550fb7b")
+				postDb2SaasRestoreOptionsModel.Headers = map[string]string{"x-custom-header": "x-custom-value"}
+				// Invoke operation with empty URL (negative test)
+				err := db2saasService.SetServiceURL("")
+				Expect(err).To(BeNil())
+				result, response, operationErr := db2saasService.PostDb2SaasRestore(postDb2SaasRestoreOptionsModel)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(operationErr.Error()).To(ContainSubstring(core.ERRORMSG_SERVICE_URL_MISSING))
+				Expect(response).To(BeNil())
+				Expect(result).To(BeNil())
+				// Construct a second instance of the PostDb2SaasRestoreOptions model with no property values
+				postDb2SaasRestoreOptionsModelNew := new(db2saasv1.PostDb2SaasRestoreOptions)
+				// Invoke operation with invalid model (negative test)
+				result, response, operationErr = db2saasService.PostDb2SaasRestore(postDb2SaasRestoreOptionsModelNew)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).To(BeNil())
+				Expect(result).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+		Context(`Using mock server endpoint with missing response body`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Set success status code with no respoonse body
+					res.WriteHeader(200)
+				}))
+			})
+			It(`Invoke PostDb2SaasRestore successfully`, func() {
+				db2saasService, serviceErr := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(db2saasService).ToNot(BeNil())
+
+				// Construct an instance of the PostDb2SaasRestoreOptions model
+				postDb2SaasRestoreOptionsModel := new(db2saasv1.PostDb2SaasRestoreOptions)
+				postDb2SaasRestoreOptionsModel.XDbProfile = core.StringPtr("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")
+				postDb2SaasRestoreOptionsModel.BackupID = core.StringPtr("crn:v1:staging:public:dashdb-for-transactions:us-east:a/e7e3e87b512f474381c0684a5ecbba03:0c9c7889-54de-4ecc-8399-09a4d4ff228e:task:51ff2dc7-6cb9-41c0-9345-09e54550fb7b")
+				postDb2SaasRestoreOptionsModel.Headers = map[string]string{"x-custom-header": "x-custom-value"}
+
+				// Invoke operation
+				result, response, operationErr := db2saasService.PostDb2SaasRestore(postDb2SaasRestoreOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(response).ToNot(BeNil())
+
+				// Verify a nil result
+				Expect(result).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
 	Describe(`Model constructor tests`, func() {
 		Context(`Using a service client instance`, func() {
 			db2saasService, _ := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
@@ -4360,9 +5055,23 @@ var _ = Describe(`Db2saasV1`, func() {
 				xDbProfile := "crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A"
 				getDb2SaasBackupOptionsModel := db2saasService.NewGetDb2SaasBackupOptions(xDbProfile)
 				getDb2SaasBackupOptionsModel.SetXDbProfile("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")
+				getDb2SaasBackupOptionsModel.SetType("scheduled")
+				getDb2SaasBackupOptionsModel.SetStatus("completed")
+				getDb2SaasBackupOptionsModel.SetCreatedAfter(CreateMockDateTime("2019-01-01T12:00:00.000Z"))
+				getDb2SaasBackupOptionsModel.SetCreatedBefore(CreateMockDateTime("2019-01-01T12:00:00.000Z"))
+				getDb2SaasBackupOptionsModel.SetSort("-created_at")
+				getDb2SaasBackupOptionsModel.SetLimit(int64(10))
+				getDb2SaasBackupOptionsModel.SetStart("testString")
 				getDb2SaasBackupOptionsModel.SetHeaders(map[string]string{"foo": "bar"})
 				Expect(getDb2SaasBackupOptionsModel).ToNot(BeNil())
 				Expect(getDb2SaasBackupOptionsModel.XDbProfile).To(Equal(core.StringPtr("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")))
+				Expect(getDb2SaasBackupOptionsModel.Type).To(Equal(core.StringPtr("scheduled")))
+				Expect(getDb2SaasBackupOptionsModel.Status).To(Equal(core.StringPtr("completed")))
+				Expect(getDb2SaasBackupOptionsModel.CreatedAfter).To(Equal(CreateMockDateTime("2019-01-01T12:00:00.000Z")))
+				Expect(getDb2SaasBackupOptionsModel.CreatedBefore).To(Equal(CreateMockDateTime("2019-01-01T12:00:00.000Z")))
+				Expect(getDb2SaasBackupOptionsModel.Sort).To(Equal(core.StringPtr("-created_at")))
+				Expect(getDb2SaasBackupOptionsModel.Limit).To(Equal(core.Int64Ptr(int64(10))))
+				Expect(getDb2SaasBackupOptionsModel.Start).To(Equal(core.StringPtr("testString")))
 				Expect(getDb2SaasBackupOptionsModel.Headers).To(Equal(map[string]string{"foo": "bar"}))
 			})
 			It(`Invoke NewGetDb2SaasConnectionInfoOptions successfully`, func() {
@@ -4378,11 +5087,23 @@ var _ = Describe(`Db2saasV1`, func() {
 				Expect(getDb2SaasConnectionInfoOptionsModel.XDeploymentID).To(Equal(core.StringPtr("crn:v1:staging:public:dashdb-for-transactions:us-south:a/e7e3e87b512f474381c0684a5ecbba03:69db420f-33d5-4953-8bd8-1950abd356f6::")))
 				Expect(getDb2SaasConnectionInfoOptionsModel.Headers).To(Equal(map[string]string{"foo": "bar"}))
 			})
+			It(`Invoke NewGetDb2SaasRestoreOptions successfully`, func() {
+				// Construct an instance of the GetDb2SaasRestoreOptions model
+				xDbProfile := "crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A"
+				getDb2SaasRestoreOptionsModel := db2saasService.NewGetDb2SaasRestoreOptions(xDbProfile)
+				getDb2SaasRestoreOptionsModel.SetXDbProfile("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")
+				getDb2SaasRestoreOptionsModel.SetHeaders(map[string]string{"foo": "bar"})
+				Expect(getDb2SaasRestoreOptionsModel).ToNot(BeNil())
+				Expect(getDb2SaasRestoreOptionsModel.XDbProfile).To(Equal(core.StringPtr("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")))
+				Expect(getDb2SaasRestoreOptionsModel.Headers).To(Equal(map[string]string{"foo": "bar"}))
+			})
 			It(`Invoke NewGetDb2SaasTuneableParamOptions successfully`, func() {
 				// Construct an instance of the GetDb2SaasTuneableParamOptions model
 				getDb2SaasTuneableParamOptionsModel := db2saasService.NewGetDb2SaasTuneableParamOptions()
+				getDb2SaasTuneableParamOptionsModel.SetXDbProfile("crn%3Av1%3Abluemix%3Apublic%3Adb2%3Aus-south%3Aa%2F00000000000000000000000000000000%3A00000000-0000-0000-0000-000000000000%3A%3A")
 				getDb2SaasTuneableParamOptionsModel.SetHeaders(map[string]string{"foo": "bar"})
 				Expect(getDb2SaasTuneableParamOptionsModel).ToNot(BeNil())
+				Expect(getDb2SaasTuneableParamOptionsModel.XDbProfile).To(Equal(core.StringPtr("crn%3Av1%3Abluemix%3Apublic%3Adb2%3Aus-south%3Aa%2F00000000000000000000000000000000%3A00000000-0000-0000-0000-000000000000%3A%3A")))
 				Expect(getDb2SaasTuneableParamOptionsModel.Headers).To(Equal(map[string]string{"foo": "bar"}))
 			})
 			It(`Invoke NewGetDb2SaasUserOptions successfully`, func() {
@@ -4798,6 +5519,20 @@ var _ = Describe(`Db2saasV1`, func() {
 				Expect(postDb2SaasDbConfigurationOptionsModel.Dbm).To(Equal(createCustomSettingsDbmModel))
 				Expect(postDb2SaasDbConfigurationOptionsModel.Headers).To(Equal(map[string]string{"foo": "bar"}))
 			})
+			It(`Invoke NewPostDb2SaasRestoreOptions successfully`, func() {
+				// Construct an instance of the PostDb2SaasRestoreOptions model
+				xDbProfile := "crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A"
+				postDb2SaasRestoreOptionsModel := db2saasService.NewPostDb2SaasRestoreOptions(xDbProfile)
+				postDb2SaasRestoreOptionsModel.SetXDbProfile("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")
+				postDb2SaasRestoreOptionsModel.SetBackupID("crn:v1:staging:public:dashdb-for-transactions:us-east:a/e7e3e87b512f474381c0684a5ecbba03:0c9c7889-54de-4ecc-8399-09a4d4ff228e:task:51ff2dc7-6cb9-41c0-9345-09e54550fb7b")
+				postDb2SaasRestoreOptionsModel.SetTargetTime("2025-01-01T00:00:00Z")
+				postDb2SaasRestoreOptionsModel.SetHeaders(map[string]string{"foo": "bar"})
+				Expect(postDb2SaasRestoreOptionsModel).ToNot(BeNil())
+				Expect(postDb2SaasRestoreOptionsModel.XDbProfile).To(Equal(core.StringPtr("crn%3Av1%3Astaging%3Apublic%3Adashdb-for-transactions%3Aus-south%3Aa%2Fe7e3e87b512f474381c0684a5ecbba03%3A39269573-e43f-43e8-8b93-09f44c2ff875%3A%3A")))
+				Expect(postDb2SaasRestoreOptionsModel.BackupID).To(Equal(core.StringPtr("crn:v1:staging:public:dashdb-for-transactions:us-east:a/e7e3e87b512f474381c0684a5ecbba03:0c9c7889-54de-4ecc-8399-09a4d4ff228e:task:51ff2dc7-6cb9-41c0-9345-09e54550fb7b")))
+				Expect(postDb2SaasRestoreOptionsModel.TargetTime).To(Equal(core.StringPtr("2025-01-01T00:00:00Z")))
+				Expect(postDb2SaasRestoreOptionsModel.Headers).To(Equal(map[string]string{"foo": "bar"}))
+			})
 			It(`Invoke NewPostDb2SaasUserOptions successfully`, func() {
 				// Construct an instance of the CreateUserAuthentication model
 				createUserAuthenticationModel := new(db2saasv1.CreateUserAuthentication)
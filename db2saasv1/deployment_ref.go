@@ -0,0 +1,354 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	common "github.com/IBM/cloud-db2-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DeploymentServiceNames are the CRN service names of Db2 deployments.
+var DeploymentServiceNames = []string{
+	"dashdb-for-transactions",
+	"dashdb-for-analytics",
+}
+
+// InvalidDeploymentRefError is returned when a deployment id is not a valid Db2 CRN.
+type InvalidDeploymentRefError struct {
+	Value  string
+	Reason string
+}
+
+func (e *InvalidDeploymentRefError) Error() string {
+	return fmt.Sprintf("invalid deployment id '%s': %s", e.Value, e.Reason)
+}
+
+// DeploymentRef : A parsed and validated Db2 deployment CRN of the form
+// "crn:v1:<cname>:<ctype>:<service-name>:<location>:a/<account>:<service-instance>::".
+//
+// Operations address a deployment either by its raw CRN (the "x-deployment-id" header, see CRN)
+// or by its URL-encoded CRN (the "x-db-profile" header, see DbProfile), and send the value they
+// are given as is. Options types carrying either header have a New*OptionsForDeployment
+// constructor and a SetDeployment method that fill in the right form.
+type DeploymentRef struct {
+	// The environment the deployment belongs to, e.g. "bluemix" or "staging".
+	CName string
+
+	// The type of the environment, e.g. "public".
+	CType string
+
+	// The service name, e.g. "dashdb-for-transactions".
+	ServiceName string
+
+	// The region or zone of the deployment, e.g. "us-south".
+	Location string
+
+	// The id of the account owning the deployment.
+	AccountID string
+
+	// The id of the deployment.
+	ServiceInstance string
+
+	crn string
+}
+
+// ParseDeploymentRef parses a Db2 deployment CRN given in raw or URL-encoded form.
+func ParseDeploymentRef(value string) (ref *DeploymentRef, err error) {
+	crn := strings.TrimSpace(value)
+	if !strings.HasPrefix(crn, "crn:") {
+		crn, err = url.QueryUnescape(crn)
+		if err != nil {
+			err = &InvalidDeploymentRefError{Value: value, Reason: err.Error()}
+			err = core.SDKErrorf(err, "", "invalid-deployment-ref", common.GetComponentInfo())
+			return
+		}
+	}
+
+	invalid := func(reason string) (*DeploymentRef, error) {
+		err := &InvalidDeploymentRefError{Value: value, Reason: reason}
+		return nil, core.SDKErrorf(err, "", "invalid-deployment-ref", common.GetComponentInfo())
+	}
+	segments := strings.Split(crn, ":")
+	if len(segments) != 10 || segments[0] != "crn" {
+		return invalid("expected a CRN with 10 segments")
+	}
+	if segments[1] != "v1" {
+		return invalid(fmt.Sprintf("unsupported CRN version '%s'", segments[1]))
+	}
+	if !containsString(DeploymentServiceNames, segments[4]) {
+		return invalid(fmt.Sprintf("service name '%s' is not a Db2 service", segments[4]))
+	}
+	if segments[5] == "" {
+		return invalid("missing location")
+	}
+	if !strings.HasPrefix(segments[6], "a/") || len(segments[6]) == len("a/") {
+		return invalid("scope must be an account, e.g. 'a/<account id>'")
+	}
+	if segments[7] == "" {
+		return invalid("missing service instance")
+	}
+
+	ref = &DeploymentRef{
+		CName:           segments[2],
+		CType:           segments[3],
+		ServiceName:     segments[4],
+		Location:        segments[5],
+		AccountID:       strings.TrimPrefix(segments[6], "a/"),
+		ServiceInstance: segments[7],
+		crn:             crn,
+	}
+	return
+}
+
+// CRN returns the raw CRN, the form used by the "x-deployment-id" header.
+func (ref *DeploymentRef) CRN() string {
+	return ref.crn
+}
+
+// DbProfile returns the URL-encoded CRN, the form used by the "x-db-profile" header.
+func (ref *DeploymentRef) DbProfile() string {
+	return url.QueryEscape(ref.crn)
+}
+
+// String returns the raw CRN.
+func (ref *DeploymentRef) String() string {
+	return ref.crn
+}
+
+// decodeDeploymentID returns the raw form of a deployment id given in either form.
+func decodeDeploymentID(value string) string {
+	if strings.HasPrefix(strings.ToLower(value), "crn%3a") {
+		if crn, err := url.QueryUnescape(value); err == nil {
+			return crn
+		}
+	}
+	return value
+}
+
+// NewGetDb2SaasConnectionInfoOptionsForDeployment : Instantiate GetDb2SaasConnectionInfoOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewGetDb2SaasConnectionInfoOptionsForDeployment(ref *DeploymentRef) *GetDb2SaasConnectionInfoOptions {
+	return db2saas.NewGetDb2SaasConnectionInfoOptions(ref.DbProfile(), ref.CRN())
+}
+
+// SetDeployment : Allow user to set DeploymentID and XDeploymentID from a DeploymentRef
+func (_options *GetDb2SaasConnectionInfoOptions) SetDeployment(ref *DeploymentRef) *GetDb2SaasConnectionInfoOptions {
+	_options.DeploymentID = core.StringPtr(ref.DbProfile())
+	_options.XDeploymentID = core.StringPtr(ref.CRN())
+	return _options
+}
+
+// NewPostDb2SaasAllowlistOptionsForDeployment : Instantiate PostDb2SaasAllowlistOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewPostDb2SaasAllowlistOptionsForDeployment(ref *DeploymentRef, ipAddresses []IpAddress) *PostDb2SaasAllowlistOptions {
+	return db2saas.NewPostDb2SaasAllowlistOptions(ref.CRN(), ipAddresses)
+}
+
+// SetDeployment : Allow user to set XDeploymentID from a DeploymentRef
+func (_options *PostDb2SaasAllowlistOptions) SetDeployment(ref *DeploymentRef) *PostDb2SaasAllowlistOptions {
+	_options.XDeploymentID = core.StringPtr(ref.CRN())
+	return _options
+}
+
+// NewGetDb2SaasAllowlistOptionsForDeployment : Instantiate GetDb2SaasAllowlistOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewGetDb2SaasAllowlistOptionsForDeployment(ref *DeploymentRef) *GetDb2SaasAllowlistOptions {
+	return db2saas.NewGetDb2SaasAllowlistOptions(ref.CRN())
+}
+
+// SetDeployment : Allow user to set XDeploymentID from a DeploymentRef
+func (_options *GetDb2SaasAllowlistOptions) SetDeployment(ref *DeploymentRef) *GetDb2SaasAllowlistOptions {
+	_options.XDeploymentID = core.StringPtr(ref.CRN())
+	return _options
+}
+
+// NewPostDb2SaasUserOptionsForDeployment : Instantiate PostDb2SaasUserOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewPostDb2SaasUserOptionsForDeployment(ref *DeploymentRef, id string, iam bool, ibmid string, name string, password string, role string, email string, locked string, authentication *CreateUserAuthentication) *PostDb2SaasUserOptions {
+	return db2saas.NewPostDb2SaasUserOptions(ref.CRN(), id, iam, ibmid, name, password, role, email, locked, authentication)
+}
+
+// SetDeployment : Allow user to set XDeploymentID from a DeploymentRef
+func (_options *PostDb2SaasUserOptions) SetDeployment(ref *DeploymentRef) *PostDb2SaasUserOptions {
+	_options.XDeploymentID = core.StringPtr(ref.CRN())
+	return _options
+}
+
+// NewGetDb2SaasUserOptionsForDeployment : Instantiate GetDb2SaasUserOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewGetDb2SaasUserOptionsForDeployment(ref *DeploymentRef) *GetDb2SaasUserOptions {
+	return db2saas.NewGetDb2SaasUserOptions(ref.CRN())
+}
+
+// SetDeployment : Allow user to set XDeploymentID from a DeploymentRef
+func (_options *GetDb2SaasUserOptions) SetDeployment(ref *DeploymentRef) *GetDb2SaasUserOptions {
+	_options.XDeploymentID = core.StringPtr(ref.CRN())
+	return _options
+}
+
+// NewPutDb2SaasUserOptionsForDeployment : Instantiate PutDb2SaasUserOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewPutDb2SaasUserOptionsForDeployment(ref *DeploymentRef, id string, newID string, newIam bool, newIbmid string, newName string, newPassword string, newRole string, newEmail string, newLocked string, newAuthentication *UpdateUserAuthentication) *PutDb2SaasUserOptions {
+	return db2saas.NewPutDb2SaasUserOptions(ref.CRN(), id, newID, newIam, newIbmid, newName, newPassword, newRole, newEmail, newLocked, newAuthentication)
+}
+
+// SetDeployment : Allow user to set XDeploymentID from a DeploymentRef
+func (_options *PutDb2SaasUserOptions) SetDeployment(ref *DeploymentRef) *PutDb2SaasUserOptions {
+	_options.XDeploymentID = core.StringPtr(ref.CRN())
+	return _options
+}
+
+// NewDeleteDb2SaasUserOptionsForDeployment : Instantiate DeleteDb2SaasUserOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewDeleteDb2SaasUserOptionsForDeployment(ref *DeploymentRef, id string) *DeleteDb2SaasUserOptions {
+	return db2saas.NewDeleteDb2SaasUserOptions(ref.CRN(), id)
+}
+
+// SetDeployment : Allow user to set XDeploymentID from a DeploymentRef
+func (_options *DeleteDb2SaasUserOptions) SetDeployment(ref *DeploymentRef) *DeleteDb2SaasUserOptions {
+	_options.XDeploymentID = core.StringPtr(ref.CRN())
+	return _options
+}
+
+// NewGetbyidDb2SaasUserOptionsForDeployment : Instantiate GetbyidDb2SaasUserOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewGetbyidDb2SaasUserOptionsForDeployment(ref *DeploymentRef, id string) *GetbyidDb2SaasUserOptions {
+	return db2saas.NewGetbyidDb2SaasUserOptions(ref.CRN(), id)
+}
+
+// SetDeployment : Allow user to set XDeploymentID from a DeploymentRef
+func (_options *GetbyidDb2SaasUserOptions) SetDeployment(ref *DeploymentRef) *GetbyidDb2SaasUserOptions {
+	_options.XDeploymentID = core.StringPtr(ref.CRN())
+	return _options
+}
+
+// NewPutDb2SaasAutoscaleOptionsForDeployment : Instantiate PutDb2SaasAutoscaleOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewPutDb2SaasAutoscaleOptionsForDeployment(ref *DeploymentRef) *PutDb2SaasAutoscaleOptions {
+	return db2saas.NewPutDb2SaasAutoscaleOptions(ref.DbProfile())
+}
+
+// SetDeployment : Allow user to set XDbProfile from a DeploymentRef
+func (_options *PutDb2SaasAutoscaleOptions) SetDeployment(ref *DeploymentRef) *PutDb2SaasAutoscaleOptions {
+	_options.XDbProfile = core.StringPtr(ref.DbProfile())
+	return _options
+}
+
+// NewGetDb2SaasAutoscaleOptionsForDeployment : Instantiate GetDb2SaasAutoscaleOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewGetDb2SaasAutoscaleOptionsForDeployment(ref *DeploymentRef) *GetDb2SaasAutoscaleOptions {
+	return db2saas.NewGetDb2SaasAutoscaleOptions(ref.DbProfile())
+}
+
+// SetDeployment : Allow user to set XDbProfile from a DeploymentRef
+func (_options *GetDb2SaasAutoscaleOptions) SetDeployment(ref *DeploymentRef) *GetDb2SaasAutoscaleOptions {
+	_options.XDbProfile = core.StringPtr(ref.DbProfile())
+	return _options
+}
+
+// NewPostDb2SaasDbConfigurationOptionsForDeployment : Instantiate PostDb2SaasDbConfigurationOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewPostDb2SaasDbConfigurationOptionsForDeployment(ref *DeploymentRef) *PostDb2SaasDbConfigurationOptions {
+	return db2saas.NewPostDb2SaasDbConfigurationOptions(ref.DbProfile())
+}
+
+// SetDeployment : Allow user to set XDbProfile from a DeploymentRef
+func (_options *PostDb2SaasDbConfigurationOptions) SetDeployment(ref *DeploymentRef) *PostDb2SaasDbConfigurationOptions {
+	_options.XDbProfile = core.StringPtr(ref.DbProfile())
+	return _options
+}
+
+// NewGetDb2SaasTuneableParamOptionsForDeployment : Instantiate GetDb2SaasTuneableParamOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewGetDb2SaasTuneableParamOptionsForDeployment(ref *DeploymentRef) *GetDb2SaasTuneableParamOptions {
+	return db2saas.NewGetDb2SaasTuneableParamOptions().SetDeployment(ref)
+}
+
+// SetDeployment : Allow user to set XDbProfile from a DeploymentRef
+func (_options *GetDb2SaasTuneableParamOptions) SetDeployment(ref *DeploymentRef) *GetDb2SaasTuneableParamOptions {
+	_options.XDbProfile = core.StringPtr(ref.DbProfile())
+	return _options
+}
+
+// NewGetDb2SaasBackupOptionsForDeployment : Instantiate GetDb2SaasBackupOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewGetDb2SaasBackupOptionsForDeployment(ref *DeploymentRef) *GetDb2SaasBackupOptions {
+	return db2saas.NewGetDb2SaasBackupOptions(ref.DbProfile())
+}
+
+// SetDeployment : Allow user to set XDbProfile from a DeploymentRef
+func (_options *GetDb2SaasBackupOptions) SetDeployment(ref *DeploymentRef) *GetDb2SaasBackupOptions {
+	_options.XDbProfile = core.StringPtr(ref.DbProfile())
+	return _options
+}
+
+// NewPostDb2SaasBackupOptionsForDeployment : Instantiate PostDb2SaasBackupOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewPostDb2SaasBackupOptionsForDeployment(ref *DeploymentRef) *PostDb2SaasBackupOptions {
+	return db2saas.NewPostDb2SaasBackupOptions(ref.DbProfile())
+}
+
+// SetDeployment : Allow user to set XDbProfile from a DeploymentRef
+func (_options *PostDb2SaasBackupOptions) SetDeployment(ref *DeploymentRef) *PostDb2SaasBackupOptions {
+	_options.XDbProfile = core.StringPtr(ref.DbProfile())
+	return _options
+}
+
+// NewGetDb2SaasRestoreOptionsForDeployment : Instantiate GetDb2SaasRestoreOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewGetDb2SaasRestoreOptionsForDeployment(ref *DeploymentRef) *GetDb2SaasRestoreOptions {
+	return db2saas.NewGetDb2SaasRestoreOptions(ref.DbProfile())
+}
+
+// SetDeployment : Allow user to set XDbProfile from a DeploymentRef
+func (_options *GetDb2SaasRestoreOptions) SetDeployment(ref *DeploymentRef) *GetDb2SaasRestoreOptions {
+	_options.XDbProfile = core.StringPtr(ref.DbProfile())
+	return _options
+}
+
+// NewPostDb2SaasRestoreOptionsForDeployment : Instantiate PostDb2SaasRestoreOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewPostDb2SaasRestoreOptionsForDeployment(ref *DeploymentRef) *PostDb2SaasRestoreOptions {
+	return db2saas.NewPostDb2SaasRestoreOptions(ref.DbProfile())
+}
+
+// SetDeployment : Allow user to set XDbProfile from a DeploymentRef
+func (_options *PostDb2SaasRestoreOptions) SetDeployment(ref *DeploymentRef) *PostDb2SaasRestoreOptions {
+	_options.XDbProfile = core.StringPtr(ref.DbProfile())
+	return _options
+}
+
+// NewWaitForBackupOptionsForDeployment : Instantiate WaitForBackupOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewWaitForBackupOptionsForDeployment(ref *DeploymentRef, backupID string) *WaitForBackupOptions {
+	return db2saas.NewWaitForBackupOptions(ref.DbProfile(), backupID)
+}
+
+// SetDeployment : Allow user to set XDbProfile from a DeploymentRef
+func (_options *WaitForBackupOptions) SetDeployment(ref *DeploymentRef) *WaitForBackupOptions {
+	_options.XDbProfile = core.StringPtr(ref.DbProfile())
+	return _options
+}
+
+// NewWaitForRestoreOptionsForDeployment : Instantiate WaitForRestoreOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewWaitForRestoreOptionsForDeployment(ref *DeploymentRef, restoreID string) *WaitForRestoreOptions {
+	return db2saas.NewWaitForRestoreOptions(ref.DbProfile(), restoreID)
+}
+
+// SetDeployment : Allow user to set XDbProfile from a DeploymentRef
+func (_options *WaitForRestoreOptions) SetDeployment(ref *DeploymentRef) *WaitForRestoreOptions {
+	_options.XDbProfile = core.StringPtr(ref.DbProfile())
+	return _options
+}
+
+// NewPlanBackupRetentionOptionsForDeployment : Instantiate PlanBackupRetentionOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewPlanBackupRetentionOptionsForDeployment(ref *DeploymentRef, retention *BackupRetention) *PlanBackupRetentionOptions {
+	return db2saas.NewPlanBackupRetentionOptions(ref.DbProfile(), retention)
+}
+
+// SetDeployment : Allow user to set XDbProfile from a DeploymentRef
+func (_options *PlanBackupRetentionOptions) SetDeployment(ref *DeploymentRef) *PlanBackupRetentionOptions {
+	_options.XDbProfile = core.StringPtr(ref.DbProfile())
+	return _options
+}
@@ -0,0 +1,157 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1_test
+
+import (
+	"errors"
+
+	"github.com/IBM/cloud-db2-go-sdk/db2saasfake"
+	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DeploymentRef`, func() {
+	Describe(`ParseDeploymentRef(value string)`, func() {
+		It(`Invoke ParseDeploymentRef successfully`, func() {
+			for _, value := range []string{fakeCRN, fakeProfile, " " + fakeCRN + " "} {
+				ref, err := db2saasv1.ParseDeploymentRef(value)
+				Expect(err).To(BeNil(), value)
+				Expect(ref.CRN()).To(Equal(fakeCRN))
+				Expect(ref.DbProfile()).To(Equal(fakeProfile))
+				Expect(ref.String()).To(Equal(fakeCRN))
+				Expect(ref.CName).To(Equal("staging"))
+				Expect(ref.CType).To(Equal("public"))
+				Expect(ref.ServiceName).To(Equal("dashdb-for-transactions"))
+				Expect(ref.Location).To(Equal("us-south"))
+				Expect(ref.AccountID).To(Equal("e7e3e87b512f474381c0684a5ecbba03"))
+				Expect(ref.ServiceInstance).To(Equal("39269573-e43f-43e8-8b93-09f44c2ff875"))
+			}
+		})
+		It(`Invoke ParseDeploymentRef with error`, func() {
+			for _, value := range []string{
+				"",
+				"39269573-e43f-43e8-8b93-09f44c2ff875",
+				"crn%ZZ",
+				"crn:v1:staging:public:dashdb-for-transactions:us-south:a/e7e3e87b512f474381c0684a5ecbba03:39269573:::",
+				"crn:v2:staging:public:dashdb-for-transactions:us-south:a/e7e3e87b512f474381c0684a5ecbba03:39269573::",
+				"crn:v1:staging:public:cloud-object-storage:us-south:a/e7e3e87b512f474381c0684a5ecbba03:39269573::",
+				"crn:v1:staging:public:dashdb-for-transactions::a/e7e3e87b512f474381c0684a5ecbba03:39269573::",
+				"crn:v1:staging:public:dashdb-for-transactions:us-south:o/e7e3e87b512f474381c0684a5ecbba03:39269573::",
+				"crn:v1:staging:public:dashdb-for-transactions:us-south:a/:39269573::",
+				"crn:v1:staging:public:dashdb-for-transactions:us-south:a/e7e3e87b512f474381c0684a5ecbba03:::",
+			} {
+				ref, err := db2saasv1.ParseDeploymentRef(value)
+				Expect(err).ToNot(BeNil(), value)
+				Expect(ref).To(BeNil())
+
+				var refErr *db2saasv1.InvalidDeploymentRefError
+				Expect(errors.As(err, &refErr)).To(BeTrue(), value)
+				Expect(refErr.Value).To(Equal(value))
+			}
+		})
+	})
+	Describe(`SetDeployment(ref *DeploymentRef)`, func() {
+		var db2saasService *db2saasv1.Db2saasV1
+		var ref *db2saasv1.DeploymentRef
+		BeforeEach(func() {
+			var err error
+			db2saasService, err = db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+				URL:           "http://db2saasv1modelgenerator.com",
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).To(BeNil())
+			ref, err = db2saasv1.ParseDeploymentRef(fakeCRN)
+			Expect(err).To(BeNil())
+		})
+		It(`Invoke SetDeployment successfully`, func() {
+			getDb2SaasConnectionInfoOptionsModel := db2saasService.NewGetDb2SaasConnectionInfoOptions("", "").SetDeployment(ref)
+			Expect(getDb2SaasConnectionInfoOptionsModel.DeploymentID).To(Equal(core.StringPtr(fakeProfile)))
+			Expect(getDb2SaasConnectionInfoOptionsModel.XDeploymentID).To(Equal(core.StringPtr(fakeCRN)))
+
+			Expect(db2saasService.NewGetDb2SaasAllowlistOptions("").SetDeployment(ref).XDeploymentID).To(Equal(core.StringPtr(fakeCRN)))
+			Expect(db2saasService.NewGetDb2SaasUserOptions("").SetDeployment(ref).XDeploymentID).To(Equal(core.StringPtr(fakeCRN)))
+			Expect(db2saasService.NewDeleteDb2SaasUserOptions("", "test-user").SetDeployment(ref).XDeploymentID).To(Equal(core.StringPtr(fakeCRN)))
+
+			Expect(db2saasService.NewGetDb2SaasAutoscaleOptions("").SetDeployment(ref).XDbProfile).To(Equal(core.StringPtr(fakeProfile)))
+			Expect(db2saasService.NewGetDb2SaasBackupOptions("").SetDeployment(ref).XDbProfile).To(Equal(core.StringPtr(fakeProfile)))
+			Expect(db2saasService.NewPostDb2SaasDbConfigurationOptions("").SetDeployment(ref).XDbProfile).To(Equal(core.StringPtr(fakeProfile)))
+			Expect(db2saasService.NewGetDb2SaasTuneableParamOptions().SetDeployment(ref).XDbProfile).To(Equal(core.StringPtr(fakeProfile)))
+			Expect(db2saasService.NewWaitForBackupOptions("", "backup-1").SetDeployment(ref).XDbProfile).To(Equal(core.StringPtr(fakeProfile)))
+			Expect(db2saasService.NewWaitForRestoreOptions("", "restore-1").SetDeployment(ref).XDbProfile).To(Equal(core.StringPtr(fakeProfile)))
+		})
+	})
+	Describe(`New*OptionsForDeployment(ref *DeploymentRef)`, func() {
+		var ref *db2saasv1.DeploymentRef
+		BeforeEach(func() {
+			var err error
+			ref, err = db2saasv1.ParseDeploymentRef(fakeCRN)
+			Expect(err).To(BeNil())
+		})
+		It(`Invoke New*OptionsForDeployment successfully`, func() {
+			db2saasService, err := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+				URL:           "http://db2saasv1modelgenerator.com",
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).To(BeNil())
+
+			getDb2SaasConnectionInfoOptionsModel := db2saasService.NewGetDb2SaasConnectionInfoOptionsForDeployment(ref)
+			Expect(getDb2SaasConnectionInfoOptionsModel.DeploymentID).To(Equal(core.StringPtr(fakeProfile)))
+			Expect(getDb2SaasConnectionInfoOptionsModel.XDeploymentID).To(Equal(core.StringPtr(fakeCRN)))
+
+			postDb2SaasUserOptionsModel := db2saasService.NewPostDb2SaasUserOptionsForDeployment(ref, "test-user", false, "test-ibmid", "test_user", "dEkMc43@gfAPl!867^dSbu", "bluuser", "test_user@mycompany.com", "no", nil)
+			Expect(postDb2SaasUserOptionsModel.XDeploymentID).To(Equal(core.StringPtr(fakeCRN)))
+			Expect(postDb2SaasUserOptionsModel.ID).To(Equal(core.StringPtr("test-user")))
+			Expect(postDb2SaasUserOptionsModel.Password).To(Equal(core.StringPtr("dEkMc43@gfAPl!867^dSbu")))
+
+			Expect(db2saasService.NewDeleteDb2SaasUserOptionsForDeployment(ref, "test-user").XDeploymentID).To(Equal(core.StringPtr(fakeCRN)))
+
+			Expect(db2saasService.NewPutDb2SaasAutoscaleOptionsForDeployment(ref).XDbProfile).To(Equal(core.StringPtr(fakeProfile)))
+			Expect(db2saasService.NewPostDb2SaasRestoreOptionsForDeployment(ref).XDbProfile).To(Equal(core.StringPtr(fakeProfile)))
+			Expect(db2saasService.NewWaitForBackupOptionsForDeployment(ref, "backup-1").BackupID).To(Equal(core.StringPtr("backup-1")))
+			Expect(db2saasService.NewPlanBackupRetentionOptionsForDeployment(ref, &db2saasv1.BackupRetention{KeepDaily: 7}).XDbProfile).To(Equal(core.StringPtr(fakeProfile)))
+			Expect(db2saasService.NewGetDb2SaasTuneableParamOptionsForDeployment(ref).XDbProfile).To(Equal(core.StringPtr(fakeProfile)))
+		})
+		It(`Invoke operations with New*OptionsForDeployment successfully`, func() {
+			fakeServer := db2saasfake.NewServer()
+			defer fakeServer.Close()
+			db2saasService, err := fakeServer.NewService()
+			Expect(err).To(BeNil())
+			fakeServer.SetConnectionInfo(fakeCRN, &db2saasv1.SuccessConnectionInfo{
+				Public: &db2saasv1.SuccessConnectionInfoPublic{Hostname: core.StringPtr("db2.example.com")},
+			})
+			fakeServer.SetSetting(fakeCRN, "db", "ACT_SORTMEM_LIMIT", "NONE")
+			fakeServer.AddBackup(fakeCRN, db2saasv1.Backup{ID: core.StringPtr("backup-1")})
+
+			successConnectionInfo, _, err := db2saasService.GetDb2SaasConnectionInfo(db2saasService.NewGetDb2SaasConnectionInfoOptionsForDeployment(ref))
+			Expect(err).To(BeNil())
+			Expect(successConnectionInfo.Public.Hostname).To(Equal(core.StringPtr("db2.example.com")))
+
+			successGetBackups, _, err := db2saasService.GetDb2SaasBackup(db2saasService.NewGetDb2SaasBackupOptionsForDeployment(ref))
+			Expect(err).To(BeNil())
+			Expect(successGetBackups.Backups).To(HaveLen(1))
+
+			successTuneableParams, _, err := db2saasService.GetDb2SaasTuneableParam(db2saasService.NewGetDb2SaasTuneableParamOptionsForDeployment(ref))
+			Expect(err).To(BeNil())
+			Expect(successTuneableParams.TuneableParam.Db.ACTSORTMEMLIMIT).To(Equal(core.StringPtr("NONE")))
+
+			_, _, err = db2saasService.GetDb2SaasUser(db2saasService.NewGetDb2SaasUserOptionsForDeployment(ref))
+			Expect(err).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,639 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1
+
+import (
+	"context"
+	"fmt"
+
+	common "github.com/IBM/cloud-db2-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Db2Instance : A handle on a single Db2 deployment.
+//
+// Every call made through the handle, or through the handles returned by Users, Allowlist, Backups,
+// Autoscale and Config, addresses the deployment the handle was created for: the "x-deployment-id" or
+// "x-db-profile" header is filled in automatically. Options passed to a call may leave the deployment
+// id empty. If they set one, it must identify the same deployment or the call fails without sending a
+// request. The options passed in are never modified.
+type Db2Instance struct {
+	service    *Db2saasV1
+	deployment *DeploymentRef
+}
+
+// Instance : Return a handle on the deployment identified by "crn", given in raw or URL-encoded form
+func (db2saas *Db2saasV1) Instance(crn string) (instance *Db2Instance, err error) {
+	deployment, err := ParseDeploymentRef(crn)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	instance = &Db2Instance{
+		service:    db2saas,
+		deployment: deployment,
+	}
+	return
+}
+
+// Deployment returns the deployment the handle addresses.
+func (instance *Db2Instance) Deployment() *DeploymentRef {
+	return instance.deployment
+}
+
+// Service returns the service the handle sends its requests through.
+func (instance *Db2Instance) Service() *Db2saasV1 {
+	return instance.service
+}
+
+// ConnectionInfo : Get Db2 connection information
+func (instance *Db2Instance) ConnectionInfo() (result *SuccessConnectionInfo, response *core.DetailedResponse, err error) {
+	result, response, err = instance.ConnectionInfoWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ConnectionInfoWithContext is an alternate form of the ConnectionInfo method which supports a Context parameter.
+func (instance *Db2Instance) ConnectionInfoWithContext(ctx context.Context) (result *SuccessConnectionInfo, response *core.DetailedResponse, err error) {
+	options := &GetDb2SaasConnectionInfoOptions{}
+	options.SetDeployment(instance.deployment)
+	return instance.service.GetDb2SaasConnectionInfoWithContext(ctx, options)
+}
+
+// Users returns a handle on the users of the deployment.
+func (instance *Db2Instance) Users() *Db2InstanceUsers {
+	return &Db2InstanceUsers{instance}
+}
+
+// Allowlist returns a handle on the IP allowlist of the deployment.
+func (instance *Db2Instance) Allowlist() *Db2InstanceAllowlist {
+	return &Db2InstanceAllowlist{instance}
+}
+
+// Backups returns a handle on the backups and restores of the deployment.
+func (instance *Db2Instance) Backups() *Db2InstanceBackups {
+	return &Db2InstanceBackups{instance}
+}
+
+// Autoscale returns a handle on the autoscaling configuration of the deployment.
+func (instance *Db2Instance) Autoscale() *Db2InstanceAutoscale {
+	return &Db2InstanceAutoscale{instance}
+}
+
+// Config returns a handle on the database configuration of the deployment.
+func (instance *Db2Instance) Config() *Db2InstanceConfig {
+	return &Db2InstanceConfig{instance}
+}
+
+// bind returns the deployment id to send in place of "value". An unset value is replaced by the
+// deployment of the handle, in raw or URL-encoded form as "encoded" requests. A set value must
+// identify the same deployment, in either form.
+func (instance *Db2Instance) bind(value *string, encoded bool) (*string, error) {
+	if value != nil && *value != "" && decodeDeploymentID(*value) != instance.deployment.CRN() {
+		err := fmt.Errorf("options address deployment '%s' but the instance handle addresses '%s'",
+			decodeDeploymentID(*value), instance.deployment.CRN())
+		return nil, core.SDKErrorf(err, "", "deployment-mismatch", common.GetComponentInfo())
+	}
+	if encoded {
+		return core.StringPtr(instance.deployment.DbProfile()), nil
+	}
+	return core.StringPtr(instance.deployment.CRN()), nil
+}
+
+// Db2InstanceUsers : The users of a Db2 deployment. See Db2Instance.Users.
+type Db2InstanceUsers struct {
+	instance *Db2Instance
+}
+
+// List : Get the list of users
+func (users *Db2InstanceUsers) List() (result *SuccessGetUserInfo, response *core.DetailedResponse, err error) {
+	result, response, err = users.ListWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListWithContext is an alternate form of the List method which supports a Context parameter.
+func (users *Db2InstanceUsers) ListWithContext(ctx context.Context) (result *SuccessGetUserInfo, response *core.DetailedResponse, err error) {
+	options := &GetDb2SaasUserOptions{}
+	options.SetDeployment(users.instance.deployment)
+	return users.instance.service.GetDb2SaasUserWithContext(ctx, options)
+}
+
+// Query : Search the users, see QueryUsers. "queryUsersOptions" may be nil; its deployment id may be left empty.
+func (users *Db2InstanceUsers) Query(queryUsersOptions *QueryUsersOptions) (result []SuccessGetUserInfoResourcesItem, response *core.DetailedResponse, err error) {
+	result, response, err = users.QueryWithContext(context.Background(), queryUsersOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// QueryWithContext is an alternate form of the Query method which supports a Context parameter.
+func (users *Db2InstanceUsers) QueryWithContext(ctx context.Context, queryUsersOptions *QueryUsersOptions) (result []SuccessGetUserInfoResourcesItem, response *core.DetailedResponse, err error) {
+	options, err := users.queryOptions(queryUsersOptions)
+	if err != nil {
+		return
+	}
+	return users.instance.service.QueryUsersWithContext(ctx, options)
+}
+
+// Pager : Return a pager over the users. "queryUsersOptions" may be nil; its deployment id may be left empty.
+func (users *Db2InstanceUsers) Pager(queryUsersOptions *QueryUsersOptions) (pager *UsersPager, err error) {
+	options, err := users.queryOptions(queryUsersOptions)
+	if err != nil {
+		return
+	}
+	return users.instance.service.NewUsersPager(options)
+}
+
+func (users *Db2InstanceUsers) queryOptions(queryUsersOptions *QueryUsersOptions) (options *QueryUsersOptions, err error) {
+	options = &QueryUsersOptions{}
+	if queryUsersOptions != nil {
+		*options = *queryUsersOptions
+	}
+	options.XDeploymentID, err = users.instance.bind(options.XDeploymentID, false)
+	if err != nil {
+		options = nil
+	}
+	return
+}
+
+// Get : Get the details of a user
+func (users *Db2InstanceUsers) Get(id string) (result *SuccessGetUserByID, response *core.DetailedResponse, err error) {
+	result, response, err = users.GetWithContext(context.Background(), id)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetWithContext is an alternate form of the Get method which supports a Context parameter.
+func (users *Db2InstanceUsers) GetWithContext(ctx context.Context, id string) (result *SuccessGetUserByID, response *core.DetailedResponse, err error) {
+	options := &GetbyidDb2SaasUserOptions{ID: core.StringPtr(id)}
+	options.SetDeployment(users.instance.deployment)
+	return users.instance.service.GetbyidDb2SaasUserWithContext(ctx, options)
+}
+
+// Create : Create a user. The deployment id in "postDb2SaasUserOptions" may be left empty.
+func (users *Db2InstanceUsers) Create(postDb2SaasUserOptions *PostDb2SaasUserOptions) (result *SuccessUserResponse, response *core.DetailedResponse, err error) {
+	result, response, err = users.CreateWithContext(context.Background(), postDb2SaasUserOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateWithContext is an alternate form of the Create method which supports a Context parameter.
+func (users *Db2InstanceUsers) CreateWithContext(ctx context.Context, postDb2SaasUserOptions *PostDb2SaasUserOptions) (result *SuccessUserResponse, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(postDb2SaasUserOptions, "postDb2SaasUserOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	options := *postDb2SaasUserOptions
+	options.XDeploymentID, err = users.instance.bind(options.XDeploymentID, false)
+	if err != nil {
+		return
+	}
+	return users.instance.service.PostDb2SaasUserWithContext(ctx, &options)
+}
+
+// Update : Update a user. The deployment id in "putDb2SaasUserOptions" may be left empty.
+func (users *Db2InstanceUsers) Update(putDb2SaasUserOptions *PutDb2SaasUserOptions) (result *SuccessUserResponse, response *core.DetailedResponse, err error) {
+	result, response, err = users.UpdateWithContext(context.Background(), putDb2SaasUserOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdateWithContext is an alternate form of the Update method which supports a Context parameter.
+func (users *Db2InstanceUsers) UpdateWithContext(ctx context.Context, putDb2SaasUserOptions *PutDb2SaasUserOptions) (result *SuccessUserResponse, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(putDb2SaasUserOptions, "putDb2SaasUserOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	options := *putDb2SaasUserOptions
+	options.XDeploymentID, err = users.instance.bind(options.XDeploymentID, false)
+	if err != nil {
+		return
+	}
+	return users.instance.service.PutDb2SaasUserWithContext(ctx, &options)
+}
+
+// Patch : Update some properties of a user, see UpdateDb2SaasUser. The deployment id in
+// "updateDb2SaasUserOptions" may be left empty.
+func (users *Db2InstanceUsers) Patch(updateDb2SaasUserOptions *UpdateDb2SaasUserOptions) (result *SuccessUserResponse, response *core.DetailedResponse, err error) {
+	result, response, err = users.PatchWithContext(context.Background(), updateDb2SaasUserOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PatchWithContext is an alternate form of the Patch method which supports a Context parameter.
+func (users *Db2InstanceUsers) PatchWithContext(ctx context.Context, updateDb2SaasUserOptions *UpdateDb2SaasUserOptions) (result *SuccessUserResponse, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(updateDb2SaasUserOptions, "updateDb2SaasUserOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	options := *updateDb2SaasUserOptions
+	options.XDeploymentID, err = users.instance.bind(options.XDeploymentID, false)
+	if err != nil {
+		return
+	}
+	return users.instance.service.UpdateDb2SaasUserWithContext(ctx, &options)
+}
+
+// BulkCreate : Create several users, see BulkCreateUsers. The deployment ids in "users" may be left empty.
+func (users *Db2InstanceUsers) BulkCreate(postDb2SaasUserOptions []PostDb2SaasUserOptions, bulkOptions BulkOptions) (results []BulkUserResult, err error) {
+	results, err = users.BulkCreateWithContext(context.Background(), postDb2SaasUserOptions, bulkOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// BulkCreateWithContext is an alternate form of the BulkCreate method which supports a Context parameter.
+func (users *Db2InstanceUsers) BulkCreateWithContext(ctx context.Context, postDb2SaasUserOptions []PostDb2SaasUserOptions, bulkOptions BulkOptions) (results []BulkUserResult, err error) {
+	options := make([]PostDb2SaasUserOptions, len(postDb2SaasUserOptions))
+	for i := range postDb2SaasUserOptions {
+		options[i] = postDb2SaasUserOptions[i]
+		options[i].XDeploymentID, err = users.instance.bind(options[i].XDeploymentID, false)
+		if err != nil {
+			return
+		}
+	}
+	return users.instance.service.BulkCreateUsers(ctx, options, bulkOptions)
+}
+
+// Sync : Converge the users on the desired users, see SyncUsers. The deployment id in "syncUsersOptions"
+// may be left empty.
+func (users *Db2InstanceUsers) Sync(syncUsersOptions *SyncUsersOptions) (result *UserSyncResult, err error) {
+	result, err = users.SyncWithContext(context.Background(), syncUsersOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SyncWithContext is an alternate form of the Sync method which supports a Context parameter.
+func (users *Db2InstanceUsers) SyncWithContext(ctx context.Context, syncUsersOptions *SyncUsersOptions) (result *UserSyncResult, err error) {
+	err = core.ValidateNotNil(syncUsersOptions, "syncUsersOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	options := *syncUsersOptions
+	options.XDeploymentID, err = users.instance.bind(options.XDeploymentID, false)
+	if err != nil {
+		return
+	}
+	return users.instance.service.SyncUsersWithContext(ctx, &options)
+}
+
+// Delete : Delete a user
+func (users *Db2InstanceUsers) Delete(id string) (response *core.DetailedResponse, err error) {
+	response, err = users.DeleteWithContext(context.Background(), id)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteWithContext is an alternate form of the Delete method which supports a Context parameter.
+func (users *Db2InstanceUsers) DeleteWithContext(ctx context.Context, id string) (response *core.DetailedResponse, err error) {
+	options := &DeleteDb2SaasUserOptions{ID: core.StringPtr(id)}
+	options.SetDeployment(users.instance.deployment)
+	return users.instance.service.DeleteDb2SaasUserWithContext(ctx, options)
+}
+
+// Db2InstanceAllowlist : The IP allowlist of a Db2 deployment. See Db2Instance.Allowlist.
+type Db2InstanceAllowlist struct {
+	instance *Db2Instance
+}
+
+// Get : Get the allowed IP addresses
+func (allowlist *Db2InstanceAllowlist) Get() (result *SuccessGetAllowlistIPs, response *core.DetailedResponse, err error) {
+	result, response, err = allowlist.GetWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetWithContext is an alternate form of the Get method which supports a Context parameter.
+func (allowlist *Db2InstanceAllowlist) GetWithContext(ctx context.Context) (result *SuccessGetAllowlistIPs, response *core.DetailedResponse, err error) {
+	options := &GetDb2SaasAllowlistOptions{}
+	options.SetDeployment(allowlist.instance.deployment)
+	return allowlist.instance.service.GetDb2SaasAllowlistWithContext(ctx, options)
+}
+
+// Set : Replace the allowed IP addresses
+func (allowlist *Db2InstanceAllowlist) Set(ipAddresses []IpAddress) (result *SuccessPostAllowedlistIPs, response *core.DetailedResponse, err error) {
+	result, response, err = allowlist.SetWithContext(context.Background(), ipAddresses)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SetWithContext is an alternate form of the Set method which supports a Context parameter.
+func (allowlist *Db2InstanceAllowlist) SetWithContext(ctx context.Context, ipAddresses []IpAddress) (result *SuccessPostAllowedlistIPs, response *core.DetailedResponse, err error) {
+	options := &PostDb2SaasAllowlistOptions{IpAddresses: ipAddresses}
+	options.SetDeployment(allowlist.instance.deployment)
+	return allowlist.instance.service.PostDb2SaasAllowlistWithContext(ctx, options)
+}
+
+// Reconcile : Compute the changes that turn the allowlist into "ipAddresses". See ReconcileAllowlist.
+func (allowlist *Db2InstanceAllowlist) Reconcile(ipAddresses []IpAddress) (result *AllowlistPlan, err error) {
+	result, err = allowlist.ReconcileWithContext(context.Background(), ipAddresses)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ReconcileWithContext is an alternate form of the Reconcile method which supports a Context parameter.
+func (allowlist *Db2InstanceAllowlist) ReconcileWithContext(ctx context.Context, ipAddresses []IpAddress) (result *AllowlistPlan, err error) {
+	options := &ReconcileAllowlistOptions{IpAddresses: ipAddresses}
+	options.SetDeployment(allowlist.instance.deployment)
+	return allowlist.instance.service.ReconcileAllowlistWithContext(ctx, options)
+}
+
+// Db2InstanceBackups : The backups and restores of a Db2 deployment. See Db2Instance.Backups.
+type Db2InstanceBackups struct {
+	instance *Db2Instance
+}
+
+// List : Get the backups. "getDb2SaasBackupOptions" may be nil; its deployment id may be left empty.
+func (backups *Db2InstanceBackups) List(getDb2SaasBackupOptions *GetDb2SaasBackupOptions) (result *SuccessGetBackups, response *core.DetailedResponse, err error) {
+	result, response, err = backups.ListWithContext(context.Background(), getDb2SaasBackupOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListWithContext is an alternate form of the List method which supports a Context parameter.
+func (backups *Db2InstanceBackups) ListWithContext(ctx context.Context, getDb2SaasBackupOptions *GetDb2SaasBackupOptions) (result *SuccessGetBackups, response *core.DetailedResponse, err error) {
+	options, err := backups.listOptions(getDb2SaasBackupOptions)
+	if err != nil {
+		return
+	}
+	return backups.instance.service.GetDb2SaasBackupWithContext(ctx, options)
+}
+
+// Pager : Return a pager over the backups. "getDb2SaasBackupOptions" may be nil; its deployment id may be left empty.
+func (backups *Db2InstanceBackups) Pager(getDb2SaasBackupOptions *GetDb2SaasBackupOptions) (pager *GetDb2SaasBackupPager, err error) {
+	options, err := backups.listOptions(getDb2SaasBackupOptions)
+	if err != nil {
+		return
+	}
+	return backups.instance.service.NewGetDb2SaasBackupPager(options)
+}
+
+func (backups *Db2InstanceBackups) listOptions(getDb2SaasBackupOptions *GetDb2SaasBackupOptions) (options *GetDb2SaasBackupOptions, err error) {
+	options = &GetDb2SaasBackupOptions{}
+	if getDb2SaasBackupOptions != nil {
+		*options = *getDb2SaasBackupOptions
+	}
+	options.XDbProfile, err = backups.instance.bind(options.XDbProfile, true)
+	if err != nil {
+		options = nil
+	}
+	return
+}
+
+// Create : Create a backup
+func (backups *Db2InstanceBackups) Create() (result *SuccessCreateBackup, response *core.DetailedResponse, err error) {
+	result, response, err = backups.CreateWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateWithContext is an alternate form of the Create method which supports a Context parameter.
+func (backups *Db2InstanceBackups) CreateWithContext(ctx context.Context) (result *SuccessCreateBackup, response *core.DetailedResponse, err error) {
+	options := &PostDb2SaasBackupOptions{}
+	options.SetDeployment(backups.instance.deployment)
+	return backups.instance.service.PostDb2SaasBackupWithContext(ctx, options)
+}
+
+// Wait : Wait for a backup to reach a terminal status. The deployment id in "waitForBackupOptions" may be left empty.
+func (backups *Db2InstanceBackups) Wait(waitForBackupOptions *WaitForBackupOptions) (result *Backup, err error) {
+	result, err = backups.WaitWithContext(context.Background(), waitForBackupOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// WaitWithContext is an alternate form of the Wait method which supports a Context parameter.
+func (backups *Db2InstanceBackups) WaitWithContext(ctx context.Context, waitForBackupOptions *WaitForBackupOptions) (result *Backup, err error) {
+	err = core.ValidateNotNil(waitForBackupOptions, "waitForBackupOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	options := *waitForBackupOptions
+	options.XDbProfile, err = backups.instance.bind(options.XDbProfile, true)
+	if err != nil {
+		return
+	}
+	return backups.instance.service.WaitForBackupWithContext(ctx, &options)
+}
+
+// Restores : Get the restores
+func (backups *Db2InstanceBackups) Restores() (result *SuccessGetRestores, response *core.DetailedResponse, err error) {
+	result, response, err = backups.RestoresWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// RestoresWithContext is an alternate form of the Restores method which supports a Context parameter.
+func (backups *Db2InstanceBackups) RestoresWithContext(ctx context.Context) (result *SuccessGetRestores, response *core.DetailedResponse, err error) {
+	options := &GetDb2SaasRestoreOptions{}
+	options.SetDeployment(backups.instance.deployment)
+	return backups.instance.service.GetDb2SaasRestoreWithContext(ctx, options)
+}
+
+// Restore : Restore the deployment. The deployment id in "postDb2SaasRestoreOptions" may be left empty.
+func (backups *Db2InstanceBackups) Restore(postDb2SaasRestoreOptions *PostDb2SaasRestoreOptions) (result *SuccessCreateRestore, response *core.DetailedResponse, err error) {
+	result, response, err = backups.RestoreWithContext(context.Background(), postDb2SaasRestoreOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// RestoreWithContext is an alternate form of the Restore method which supports a Context parameter.
+func (backups *Db2InstanceBackups) RestoreWithContext(ctx context.Context, postDb2SaasRestoreOptions *PostDb2SaasRestoreOptions) (result *SuccessCreateRestore, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(postDb2SaasRestoreOptions, "postDb2SaasRestoreOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	options := *postDb2SaasRestoreOptions
+	options.XDbProfile, err = backups.instance.bind(options.XDbProfile, true)
+	if err != nil {
+		return
+	}
+	return backups.instance.service.PostDb2SaasRestoreWithContext(ctx, &options)
+}
+
+// WaitForRestore : Wait for a restore to reach a terminal status. The deployment id in "waitForRestoreOptions" may be left empty.
+func (backups *Db2InstanceBackups) WaitForRestore(waitForRestoreOptions *WaitForRestoreOptions) (result *Restore, err error) {
+	result, err = backups.WaitForRestoreWithContext(context.Background(), waitForRestoreOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// WaitForRestoreWithContext is an alternate form of the WaitForRestore method which supports a Context parameter.
+func (backups *Db2InstanceBackups) WaitForRestoreWithContext(ctx context.Context, waitForRestoreOptions *WaitForRestoreOptions) (result *Restore, err error) {
+	err = core.ValidateNotNil(waitForRestoreOptions, "waitForRestoreOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	options := *waitForRestoreOptions
+	options.XDbProfile, err = backups.instance.bind(options.XDbProfile, true)
+	if err != nil {
+		return
+	}
+	return backups.instance.service.WaitForRestoreWithContext(ctx, &options)
+}
+
+// PlanRetention : Evaluate a retention policy against the backups
+func (backups *Db2InstanceBackups) PlanRetention(retention *BackupRetention) (result *BackupRetentionPlan, err error) {
+	result, err = backups.PlanRetentionWithContext(context.Background(), retention)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PlanRetentionWithContext is an alternate form of the PlanRetention method which supports a Context parameter.
+func (backups *Db2InstanceBackups) PlanRetentionWithContext(ctx context.Context, retention *BackupRetention) (result *BackupRetentionPlan, err error) {
+	options := &PlanBackupRetentionOptions{Retention: retention}
+	options.SetDeployment(backups.instance.deployment)
+	return backups.instance.service.PlanBackupRetentionWithContext(ctx, options)
+}
+
+// Db2InstanceAutoscale : The autoscaling configuration of a Db2 deployment. See Db2Instance.Autoscale.
+type Db2InstanceAutoscale struct {
+	instance *Db2Instance
+}
+
+// Get : Get the autoscaling configuration
+func (autoscale *Db2InstanceAutoscale) Get() (result *SuccessAutoScaling, response *core.DetailedResponse, err error) {
+	result, response, err = autoscale.GetWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetWithContext is an alternate form of the Get method which supports a Context parameter.
+func (autoscale *Db2InstanceAutoscale) GetWithContext(ctx context.Context) (result *SuccessAutoScaling, response *core.DetailedResponse, err error) {
+	options := &GetDb2SaasAutoscaleOptions{}
+	options.SetDeployment(autoscale.instance.deployment)
+	return autoscale.instance.service.GetDb2SaasAutoscaleWithContext(ctx, options)
+}
+
+// Update : Update the autoscaling configuration. The deployment id in "putDb2SaasAutoscaleOptions" may be left empty.
+func (autoscale *Db2InstanceAutoscale) Update(putDb2SaasAutoscaleOptions *PutDb2SaasAutoscaleOptions) (result *SuccessUpdateAutoScale, response *core.DetailedResponse, err error) {
+	result, response, err = autoscale.UpdateWithContext(context.Background(), putDb2SaasAutoscaleOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdateWithContext is an alternate form of the Update method which supports a Context parameter.
+func (autoscale *Db2InstanceAutoscale) UpdateWithContext(ctx context.Context, putDb2SaasAutoscaleOptions *PutDb2SaasAutoscaleOptions) (result *SuccessUpdateAutoScale, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(putDb2SaasAutoscaleOptions, "putDb2SaasAutoscaleOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	options := *putDb2SaasAutoscaleOptions
+	options.XDbProfile, err = autoscale.instance.bind(options.XDbProfile, true)
+	if err != nil {
+		return
+	}
+	return autoscale.instance.service.PutDb2SaasAutoscaleWithContext(ctx, &options)
+}
+
+// Db2InstanceConfig : The database configuration of a Db2 deployment. See Db2Instance.Config.
+type Db2InstanceConfig struct {
+	instance *Db2Instance
+}
+
+// Get : Get the tuneable parameters
+func (config *Db2InstanceConfig) Get() (result *SuccessTuneableParams, response *core.DetailedResponse, err error) {
+	result, response, err = config.GetWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetWithContext is an alternate form of the Get method which supports a Context parameter.
+func (config *Db2InstanceConfig) GetWithContext(ctx context.Context) (result *SuccessTuneableParams, response *core.DetailedResponse, err error) {
+	options := &GetDb2SaasTuneableParamOptions{}
+	options.SetDeployment(config.instance.deployment)
+	return config.instance.service.GetDb2SaasTuneableParamWithContext(ctx, options)
+}
+
+// Update : Update the database configuration. The deployment id in "postDb2SaasDbConfigurationOptions" may be left empty.
+func (config *Db2InstanceConfig) Update(postDb2SaasDbConfigurationOptions *PostDb2SaasDbConfigurationOptions) (result *SuccessPostCustomSettings, response *core.DetailedResponse, err error) {
+	result, response, err = config.UpdateWithContext(context.Background(), postDb2SaasDbConfigurationOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdateWithContext is an alternate form of the Update method which supports a Context parameter.
+func (config *Db2InstanceConfig) UpdateWithContext(ctx context.Context, postDb2SaasDbConfigurationOptions *PostDb2SaasDbConfigurationOptions) (result *SuccessPostCustomSettings, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(postDb2SaasDbConfigurationOptions, "postDb2SaasDbConfigurationOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	options := *postDb2SaasDbConfigurationOptions
+	options.XDbProfile, err = config.instance.bind(options.XDbProfile, true)
+	if err != nil {
+		return
+	}
+	return config.instance.service.PostDb2SaasDbConfigurationWithContext(ctx, &options)
+}
+
+// Diff : Get the settings of "postDb2SaasDbConfigurationOptions" that Update would change, see DiffConfiguration.
+// The deployment id in "postDb2SaasDbConfigurationOptions" may be left empty.
+func (config *Db2InstanceConfig) Diff(postDb2SaasDbConfigurationOptions *PostDb2SaasDbConfigurationOptions) (result *ConfigChangeset, err error) {
+	result, err = config.DiffWithContext(context.Background(), postDb2SaasDbConfigurationOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DiffWithContext is an alternate form of the Diff method which supports a Context parameter.
+func (config *Db2InstanceConfig) DiffWithContext(ctx context.Context, postDb2SaasDbConfigurationOptions *PostDb2SaasDbConfigurationOptions) (result *ConfigChangeset, err error) {
+	err = core.ValidateNotNil(postDb2SaasDbConfigurationOptions, "postDb2SaasDbConfigurationOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	options := *postDb2SaasDbConfigurationOptions
+	options.XDbProfile, err = config.instance.bind(options.XDbProfile, true)
+	if err != nil {
+		return
+	}
+	current, _, err := config.GetWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-configuration-error")
+		return
+	}
+	return DiffConfiguration(current, &options), nil
+}
+
+// Begin : Save the current values of the settings a configuration change touches, see BeginConfigTransaction.
+// The deployment id in "postDb2SaasDbConfigurationOptions" may be left empty.
+func (config *Db2InstanceConfig) Begin(postDb2SaasDbConfigurationOptions *PostDb2SaasDbConfigurationOptions) (transaction *ConfigTransaction, err error) {
+	transaction, err = config.BeginWithContext(context.Background(), postDb2SaasDbConfigurationOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// BeginWithContext is an alternate form of the Begin method which supports a Context parameter.
+func (config *Db2InstanceConfig) BeginWithContext(ctx context.Context, postDb2SaasDbConfigurationOptions *PostDb2SaasDbConfigurationOptions) (transaction *ConfigTransaction, err error) {
+	err = core.ValidateNotNil(postDb2SaasDbConfigurationOptions, "postDb2SaasDbConfigurationOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	options := *postDb2SaasDbConfigurationOptions
+	options.XDbProfile, err = config.instance.bind(options.XDbProfile, true)
+	if err != nil {
+		return
+	}
+	return config.instance.service.BeginConfigTransactionWithContext(ctx, &options)
+}
@@ -0,0 +1,197 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1_test
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/IBM/cloud-db2-go-sdk/db2saasfake"
+	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Db2Instance`, func() {
+	const otherCRN = "crn:v1:staging:public:dashdb-for-transactions:eu-de:a/e7e3e87b512f474381c0684a5ecbba03:1c5e2b0a-7f5d-4d0e-9a55-6f1b0c2d3e4f::"
+
+	var fakeServer *db2saasfake.Server
+	var db2saasService *db2saasv1.Db2saasV1
+	var instance *db2saasv1.Db2Instance
+	var other *db2saasv1.Db2Instance
+	ctx := context.Background()
+	BeforeEach(func() {
+		var err error
+		fakeServer = db2saasfake.NewServer()
+		db2saasService, err = fakeServer.NewService()
+		Expect(err).To(BeNil())
+		instance, err = db2saasService.Instance(fakeCRN)
+		Expect(err).To(BeNil())
+		other, err = db2saasService.Instance(url.QueryEscape(otherCRN))
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		fakeServer.Close()
+	})
+
+	Describe(`Instance(crn string)`, func() {
+		It(`Invoke Instance successfully`, func() {
+			Expect(instance.Deployment().CRN()).To(Equal(fakeCRN))
+			Expect(other.Deployment().CRN()).To(Equal(otherCRN))
+			Expect(instance.Service()).To(BeIdenticalTo(db2saasService))
+		})
+		It(`Invoke Instance with error`, func() {
+			instance, err := db2saasService.Instance("39269573-e43f-43e8-8b93-09f44c2ff875")
+			Expect(err).ToNot(BeNil())
+			Expect(instance).To(BeNil())
+		})
+	})
+	Describe(`Db2Instance handles`, func() {
+		It(`Invoke ConnectionInfo successfully`, func() {
+			fakeServer.SetConnectionInfo(fakeCRN, &db2saasv1.SuccessConnectionInfo{
+				Public: &db2saasv1.SuccessConnectionInfoPublic{Hostname: core.StringPtr("db2.example.com")},
+			})
+			successConnectionInfo, _, err := instance.ConnectionInfoWithContext(ctx)
+			Expect(err).To(BeNil())
+			Expect(successConnectionInfo.Public.Hostname).To(Equal(core.StringPtr("db2.example.com")))
+
+			_, _, err = other.ConnectionInfoWithContext(ctx)
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Invoke Users successfully`, func() {
+			authentication, err := db2saasService.NewCreateUserAuthentication("internal", "Default")
+			Expect(err).To(BeNil())
+			postDb2SaasUserOptionsModel := db2saasService.NewPostDb2SaasUserOptions("", "test-user", false, "test-ibm-id", "Test User",
+				"dEkMc43@gfAPl!867^dSbu", "bluuser", "test@host.org", "no", authentication)
+			_, _, err = instance.Users().CreateWithContext(ctx, postDb2SaasUserOptionsModel)
+			Expect(err).To(BeNil())
+			Expect(*postDb2SaasUserOptionsModel.XDeploymentID).To(BeEmpty())
+			Expect(fakeServer.UserIDs(fakeCRN)).To(Equal([]string{"test-user"}))
+			Expect(fakeServer.UserIDs(otherCRN)).To(BeEmpty())
+
+			successGetUserInfo, _, err := instance.Users().ListWithContext(ctx)
+			Expect(err).To(BeNil())
+			Expect(*successGetUserInfo.Count).To(Equal(int64(1)))
+			successGetUserByID, _, err := instance.Users().GetWithContext(ctx, "test-user")
+			Expect(err).To(BeNil())
+			Expect(*successGetUserByID.ID).To(Equal("test-user"))
+
+			_, _, err = other.Users().GetWithContext(ctx, "test-user")
+			Expect(err).ToNot(BeNil())
+
+			_, err = instance.Users().DeleteWithContext(ctx, "test-user")
+			Expect(err).To(BeNil())
+			Expect(fakeServer.UserIDs(fakeCRN)).To(BeEmpty())
+		})
+		It(`Invoke Allowlist successfully`, func() {
+			_, _, err := instance.Allowlist().SetWithContext(ctx, []db2saasv1.IpAddress{
+				{Address: core.StringPtr("127.0.0.1"), Description: core.StringPtr("A sample IP address")},
+			})
+			Expect(err).To(BeNil())
+
+			successGetAllowlistIPs, _, err := instance.Allowlist().GetWithContext(ctx)
+			Expect(err).To(BeNil())
+			Expect(successGetAllowlistIPs.IpAddresses).To(HaveLen(1))
+			Expect(fakeServer.Allowlist(otherCRN)).To(BeEmpty())
+		})
+		It(`Invoke Backups successfully`, func() {
+			successCreateBackup, _, err := instance.Backups().CreateWithContext(ctx)
+			Expect(err).To(BeNil())
+			backupID := *successCreateBackup.Task.ID
+
+			waitForBackupOptionsModel := db2saasService.NewWaitForBackupOptions("", backupID)
+			waitForBackupOptionsModel.SetBackoff(fastBackoff)
+			backup, err := instance.Backups().WaitWithContext(ctx, waitForBackupOptionsModel)
+			Expect(err).To(BeNil())
+			Expect(*backup.Status).To(Equal(db2saasv1.Backup_Status_Completed))
+
+			successGetBackups, _, err := instance.Backups().ListWithContext(ctx, nil)
+			Expect(err).To(BeNil())
+			Expect(successGetBackups.Backups).To(HaveLen(1))
+			successGetBackups, _, err = other.Backups().ListWithContext(ctx, nil)
+			Expect(err).To(BeNil())
+			Expect(successGetBackups.Backups).To(BeEmpty())
+
+			pager, err := instance.Backups().Pager(nil)
+			Expect(err).To(BeNil())
+			allBackups, err := pager.GetAll()
+			Expect(err).To(BeNil())
+			Expect(allBackups).To(HaveLen(1))
+
+			successCreateRestore, _, err := instance.Backups().RestoreWithContext(ctx, db2saasService.NewPostDb2SaasRestoreOptions("").SetBackupID(backupID))
+			Expect(err).To(BeNil())
+			waitForRestoreOptionsModel := db2saasService.NewWaitForRestoreOptions("", *successCreateRestore.Task.ID)
+			waitForRestoreOptionsModel.SetBackoff(fastBackoff)
+			restore, err := instance.Backups().WaitForRestoreWithContext(ctx, waitForRestoreOptionsModel)
+			Expect(err).To(BeNil())
+			Expect(*restore.Status).To(Equal(db2saasv1.Restore_Status_Completed))
+
+			successGetRestores, _, err := instance.Backups().RestoresWithContext(ctx)
+			Expect(err).To(BeNil())
+			Expect(successGetRestores.Restores).To(HaveLen(1))
+
+			plan, err := instance.Backups().PlanRetentionWithContext(ctx, &db2saasv1.BackupRetention{KeepDaily: 1})
+			Expect(err).To(BeNil())
+			Expect(plan.Keep).To(HaveLen(1))
+		})
+		It(`Invoke Autoscale and Config successfully`, func() {
+			_, _, err := instance.Autoscale().UpdateWithContext(ctx, db2saasService.NewPutDb2SaasAutoscaleOptions("").SetAutoScalingEnabled("true"))
+			Expect(err).To(BeNil())
+			successAutoScaling, _, err := instance.Autoscale().GetWithContext(ctx)
+			Expect(err).To(BeNil())
+			Expect(*successAutoScaling.AutoScalingEnabled).To(BeTrue())
+			successAutoScaling, _, err = other.Autoscale().GetWithContext(ctx)
+			Expect(err).To(BeNil())
+			Expect(*successAutoScaling.AutoScalingEnabled).To(BeFalse())
+
+			fakeServer.SetSetting(fakeCRN, "db", "ACT_SORTMEM_LIMIT", "NONE")
+			successTuneableParams, _, err := instance.Config().GetWithContext(ctx)
+			Expect(err).To(BeNil())
+			Expect(successTuneableParams.TuneableParam.Db.ACTSORTMEMLIMIT).To(Equal(core.StringPtr("NONE")))
+
+			_, _, err = instance.Config().UpdateWithContext(ctx, db2saasService.NewPostDb2SaasDbConfigurationOptions(""))
+			Expect(err).To(BeNil())
+		})
+		It(`Invoke handles with error: Deployment mismatch`, func() {
+			_, _, err := instance.Backups().ListWithContext(ctx, db2saasService.NewGetDb2SaasBackupOptions(url.QueryEscape(otherCRN)))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("instance handle addresses"))
+
+			_, _, err = instance.Autoscale().UpdateWithContext(ctx, db2saasService.NewPutDb2SaasAutoscaleOptions(otherCRN))
+			Expect(err).ToNot(BeNil())
+			_, _, err = instance.Users().UpdateWithContext(ctx, db2saasService.NewPutDb2SaasUserOptions(otherCRN, "test-user", "test-user", false, "", "", "", "bluuser", "", "no", nil))
+			Expect(err).ToNot(BeNil())
+
+			_, _, err = instance.Backups().ListWithContext(ctx, db2saasService.NewGetDb2SaasBackupOptions(fakeCRN))
+			Expect(err).To(BeNil())
+			_, _, err = instance.Backups().ListWithContext(ctx, db2saasService.NewGetDb2SaasBackupOptions(fakeProfile))
+			Expect(err).To(BeNil())
+			Expect(fakeServer.Calls(db2saasfake.RouteGetBackups)).To(Equal(2))
+			Expect(fakeServer.Calls(db2saasfake.RoutePutAutoscale)).To(BeZero())
+			Expect(fakeServer.Calls(db2saasfake.RoutePutUser)).To(BeZero())
+		})
+		It(`Invoke handles with error: Operation validation`, func() {
+			_, _, err := instance.Users().CreateWithContext(ctx, nil)
+			Expect(err).ToNot(BeNil())
+			_, err = instance.Backups().WaitWithContext(ctx, nil)
+			Expect(err).ToNot(BeNil())
+			_, _, err = instance.Config().UpdateWithContext(ctx, nil)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
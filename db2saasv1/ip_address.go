@@ -0,0 +1,294 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1
+
+import (
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+
+	common "github.com/IBM/cloud-db2-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ParseIpAddress parses an allowlist address: an IPv4 or IPv6 address, or a range in CIDR notation.
+// A single address is returned as a prefix covering only that address. Bits set beyond the prefix
+// length of a range are cleared, so "10.0.0.5/24" yields 10.0.0.0/24.
+func ParseIpAddress(address string) (netip.Prefix, error) {
+	prefix, err := parseIpAddress(address)
+	if err != nil {
+		return netip.Prefix{}, core.SDKErrorf(err, "", "invalid-ip-address", common.GetComponentInfo())
+	}
+	return prefix, nil
+}
+
+// parseIpAddress is ParseIpAddress without the SDK error wrapping.
+func parseIpAddress(address string) (netip.Prefix, error) {
+	s := strings.TrimSpace(address)
+	switch {
+	case s == "":
+		return netip.Prefix{}, fmt.Errorf("address is empty")
+	case strings.Contains(s, "%"):
+		return netip.Prefix{}, fmt.Errorf("invalid address '%s': zoned IPv6 addresses are not allowed", address)
+	case strings.Contains(s, "/"):
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid CIDR '%s': %w", address, err)
+		}
+		return prefix.Masked(), nil
+	default:
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid IP address '%s': %w", address, err)
+		}
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+}
+
+// NormalizeIpAddress returns the canonical form of an allowlist address: a bare address for a single
+// host, e.g. "10.0.0.1" for "10.0.0.1/32", and the masked range otherwise, e.g. "10.0.0.0/24" for
+// "10.0.0.5/24". IPv6 addresses are compressed and lower-cased.
+func NormalizeIpAddress(address string) (string, error) {
+	prefix, err := ParseIpAddress(address)
+	if err != nil {
+		return "", err
+	}
+	return formatPrefix(prefix), nil
+}
+
+// formatPrefix formats a prefix in the form returned by NormalizeIpAddress.
+func formatPrefix(prefix netip.Prefix) string {
+	if prefix.IsSingleIP() {
+		return prefix.Addr().String()
+	}
+	return prefix.String()
+}
+
+// IpAddressError : The reason an entry of an allowlist is invalid.
+type IpAddressError struct {
+	// The index of the entry in the allowlist.
+	Index int `json:"index"`
+
+	// The address of the entry, as given.
+	Address string `json:"address"`
+
+	// Why the entry is invalid.
+	Reason string `json:"reason"`
+
+	// The index of the earlier entry this one duplicates or overlaps, if any.
+	ConflictIndex *int `json:"conflict_index,omitempty"`
+}
+
+func (e *IpAddressError) Error() string {
+	return fmt.Sprintf("ip_addresses[%d]: %s", e.Index, e.Reason)
+}
+
+// AllowlistValidationError : The invalid entries of an allowlist, in index order.
+type AllowlistValidationError struct {
+	Errors []*IpAddressError `json:"errors"`
+}
+
+func (e *AllowlistValidationError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, entryErr := range e.Errors {
+		messages[i] = entryErr.Error()
+	}
+	return "invalid allowlist: " + strings.Join(messages, "; ")
+}
+
+// ValidateAllowlist checks that every address of the allowlist parses and that no two entries
+// are equal or overlap. It returns an *AllowlistValidationError listing every invalid entry.
+func ValidateAllowlist(ipAddresses []IpAddress) error {
+	validationErr := &AllowlistValidationError{}
+	prefixes := make([]netip.Prefix, len(ipAddresses))
+	for i := range ipAddresses {
+		address := core.StringNilMapper(ipAddresses[i].Address)
+		prefix, err := parseIpAddress(address)
+		if err != nil {
+			validationErr.Errors = append(validationErr.Errors, &IpAddressError{
+				Index:   i,
+				Address: address,
+				Reason:  err.Error(),
+			})
+			continue
+		}
+		prefixes[i] = prefix
+		for j := 0; j < i; j++ {
+			if !prefixes[j].IsValid() || !prefixes[j].Overlaps(prefix) {
+				continue
+			}
+			reason := fmt.Sprintf("'%s' overlaps ip_addresses[%d] '%s'", address, j, core.StringNilMapper(ipAddresses[j].Address))
+			if prefixes[j] == prefix {
+				reason = fmt.Sprintf("'%s' duplicates ip_addresses[%d] '%s'", address, j, core.StringNilMapper(ipAddresses[j].Address))
+			}
+			validationErr.Errors = append(validationErr.Errors, &IpAddressError{
+				Index:         i,
+				Address:       address,
+				Reason:        reason,
+				ConflictIndex: &j,
+			})
+			break
+		}
+	}
+	if len(validationErr.Errors) > 0 {
+		return validationErr
+	}
+	return nil
+}
+
+// SetValidateAllowlist sets whether PostDb2SaasAllowlist checks the allowlist with ValidateAllowlist before
+// sending a request. The check is on by default; turn it off to send overlapping entries, or addresses that
+// do not parse, and leave them to the service.
+func (db2saas *Db2saasV1) SetValidateAllowlist(validate bool) {
+	db2saas.skipAllowlistValidation = !validate
+}
+
+// GetValidateAllowlist returns true if PostDb2SaasAllowlist checks the allowlist.
+func (db2saas *Db2saasV1) GetValidateAllowlist() bool {
+	return !db2saas.skipAllowlistValidation
+}
+
+// validateAllowlist checks the allowlist of a PostDb2SaasAllowlist request, unless the check is off.
+func (db2saas *Db2saasV1) validateAllowlist(ipAddresses []IpAddress) error {
+	if db2saas.skipAllowlistValidation {
+		return nil
+	}
+	return ValidateAllowlist(ipAddresses)
+}
+
+// AggregateAllowlist returns an equivalent allowlist with as few entries as possible. Duplicate entries and
+// entries contained in another are dropped, and pairs of ranges that together form a larger CIDR range, such
+// as 10.0.0.0/24 and 10.0.1.0/24, are merged repeatedly. Adjacent ranges that do not form a CIDR range, such
+// as 10.0.1.0/24 and 10.0.2.0/24, are kept apart.
+//
+// The description of a merged entry lists the distinct, non-empty descriptions of the entries it replaces,
+// in their original order, separated by "; ". The result holds the IPv4 ranges, then the IPv6 ranges, each
+// in address order, with addresses normalized as by NormalizeIpAddress. Unlike ValidateAllowlist, overlapping
+// entries are accepted; an *AllowlistValidationError is returned only for addresses that do not parse.
+func AggregateAllowlist(ipAddresses []IpAddress) ([]IpAddress, error) {
+	type entry struct {
+		prefix       netip.Prefix
+		descriptions []string
+	}
+	validationErr := &AllowlistValidationError{}
+	entries := make([]entry, 0, len(ipAddresses))
+	for i := range ipAddresses {
+		address := core.StringNilMapper(ipAddresses[i].Address)
+		prefix, err := parseIpAddress(address)
+		if err != nil {
+			validationErr.Errors = append(validationErr.Errors, &IpAddressError{
+				Index:   i,
+				Address: address,
+				Reason:  err.Error(),
+			})
+			continue
+		}
+		var descriptions []string
+		if description := core.StringNilMapper(ipAddresses[i].Description); description != "" {
+			descriptions = []string{description}
+		}
+		entries = append(entries, entry{prefix, descriptions})
+	}
+	if len(validationErr.Errors) > 0 {
+		return nil, validationErr
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i].prefix, entries[j].prefix
+		if a.Addr().Is4() != b.Addr().Is4() {
+			return a.Addr().Is4()
+		}
+		if c := a.Addr().Compare(b.Addr()); c != 0 {
+			return c < 0
+		}
+		return a.Bits() < b.Bits()
+	})
+	mergeDescriptions := func(into *entry, from entry) {
+		for _, description := range from.descriptions {
+			if !containsString(into.descriptions, description) {
+				into.descriptions = append(into.descriptions, description)
+			}
+		}
+	}
+
+	// Entries are sorted so that a range comes before the ranges it contains and before the ranges that
+	// follow it. Every range on the stack therefore ends before the next entry begins, unless the top
+	// contains that entry, and only the top two can form a larger range.
+	var stack []entry
+	for _, next := range entries {
+		if n := len(stack); n > 0 && stack[n-1].prefix.Addr().Is4() == next.prefix.Addr().Is4() &&
+			stack[n-1].prefix.Bits() <= next.prefix.Bits() && stack[n-1].prefix.Contains(next.prefix.Addr()) {
+			mergeDescriptions(&stack[n-1], next)
+			continue
+		}
+		stack = append(stack, next)
+		for n := len(stack); n >= 2; n = len(stack) {
+			low, high := stack[n-2], stack[n-1]
+			bits := high.prefix.Bits()
+			if bits == 0 || low.prefix.Bits() != bits || low.prefix.Addr().Is4() != high.prefix.Addr().Is4() {
+				break
+			}
+			parent, _ := low.prefix.Addr().Prefix(bits - 1)
+			if parent.Addr() != low.prefix.Addr() || !parent.Contains(high.prefix.Addr()) {
+				break
+			}
+			merged := entry{prefix: parent, descriptions: low.descriptions}
+			mergeDescriptions(&merged, high)
+			stack = append(stack[:n-2], merged)
+		}
+	}
+
+	result := make([]IpAddress, len(stack))
+	for i := range stack {
+		result[i] = IpAddress{
+			Address:     core.StringPtr(formatPrefix(stack[i].prefix)),
+			Description: core.StringPtr(strings.Join(stack[i].descriptions, "; ")),
+		}
+	}
+	return result, nil
+}
+
+// Lookup returns the entries of the allowlist that allow "ip", an IPv4 or IPv6 address. Entries that do not
+// parse are ignored.
+func (allowlist *SuccessGetAllowlistIPs) Lookup(ip string) (matches []IpAddress, err error) {
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		err = core.SDKErrorf(fmt.Errorf("invalid IP address '%s': %w", ip, err), "", "invalid-ip-address", common.GetComponentInfo())
+		return
+	}
+	addr = addr.WithZone("").Unmap()
+	for i := range allowlist.IpAddresses {
+		prefix, parseErr := parseIpAddress(core.StringNilMapper(allowlist.IpAddresses[i].Address))
+		if parseErr != nil {
+			continue
+		}
+		if prefix.Addr().Is4In6() && prefix.Bits() >= 96 {
+			prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96)
+		}
+		if prefix.Contains(addr) {
+			matches = append(matches, allowlist.IpAddresses[i])
+		}
+	}
+	return
+}
+
+// Contains returns true if the allowlist allows "ip", an IPv4 or IPv6 address.
+func (allowlist *SuccessGetAllowlistIPs) Contains(ip string) (bool, error) {
+	matches, err := allowlist.Lookup(ip)
+	return len(matches) > 0, err
+}
@@ -0,0 +1,245 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1_test
+
+import (
+	"errors"
+
+	"github.com/IBM/cloud-db2-go-sdk/db2saasfake"
+	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`IpAddress validation`, func() {
+	newIpAddress := func(address string) db2saasv1.IpAddress {
+		return db2saasv1.IpAddress{Address: core.StringPtr(address), Description: core.StringPtr("description")}
+	}
+
+	Describe(`NormalizeIpAddress(address string)`, func() {
+		It(`Invoke NormalizeIpAddress successfully`, func() {
+			for address, expected := range map[string]string{
+				"10.0.0.1":              "10.0.0.1",
+				" 10.0.0.1/32 ":         "10.0.0.1",
+				"10.0.0.5/24":           "10.0.0.0/24",
+				"0.0.0.0/0":             "0.0.0.0/0",
+				"2001:DB8:0:0:0:0:0:1":  "2001:db8::1",
+				"2001:db8::/32":         "2001:db8::/32",
+				"2001:db8::1234/64":     "2001:db8::/64",
+				"2001:db8::1/128":       "2001:db8::1",
+				"::ffff:192.168.0.1":    "::ffff:192.168.0.1",
+				"fd00:1:2:3::/48":       "fd00:1:2::/48",
+				"192.168.100.200/30":    "192.168.100.200/30",
+				"192.168.100.201/30":    "192.168.100.200/30",
+				"fe80::1:2:3:4/10":      "fe80::/10",
+				"2001:0db8:0000::0/127": "2001:db8::/127",
+			} {
+				normalized, err := db2saasv1.NormalizeIpAddress(address)
+				Expect(err).To(BeNil(), address)
+				Expect(normalized).To(Equal(expected), address)
+			}
+		})
+		It(`Invoke NormalizeIpAddress with error`, func() {
+			for _, address := range []string{
+				"", "  ", "10.0.0.300", "10.0.0.300/24", "10.0.0.0/33", "10.0.0", "10.0.0.1/", "2001:db8::/129",
+				"2001:db8:::1", "fe80::1%eth0", "example.com", "010.0.0.1",
+			} {
+				_, err := db2saasv1.NormalizeIpAddress(address)
+				Expect(err).ToNot(BeNil(), address)
+			}
+		})
+	})
+	Describe(`ValidateAllowlist(ipAddresses []IpAddress)`, func() {
+		It(`Invoke ValidateAllowlist successfully`, func() {
+			Expect(db2saasv1.ValidateAllowlist(nil)).To(BeNil())
+			Expect(db2saasv1.ValidateAllowlist([]db2saasv1.IpAddress{
+				newIpAddress("10.0.0.0/24"),
+				newIpAddress("10.0.1.0/24"),
+				newIpAddress("10.0.2.1"),
+				newIpAddress("2001:db8::/64"),
+				newIpAddress("2001:db8:0:1::1"),
+			})).To(BeNil())
+		})
+		It(`Invoke ValidateAllowlist with error`, func() {
+			err := db2saasv1.ValidateAllowlist([]db2saasv1.IpAddress{
+				newIpAddress("10.0.0.0/24"),
+				newIpAddress("10.0.0.300/24"),
+				newIpAddress("10.0.0.17"),
+				newIpAddress("2001:db8::1"),
+				newIpAddress("2001:db8::1/128"),
+				{Description: core.StringPtr("no address")},
+			})
+			Expect(err).ToNot(BeNil())
+
+			var validationErr *db2saasv1.AllowlistValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Errors).To(HaveLen(4))
+
+			Expect(validationErr.Errors[0].Index).To(Equal(1))
+			Expect(validationErr.Errors[0].Reason).To(ContainSubstring("invalid CIDR '10.0.0.300/24'"))
+			Expect(validationErr.Errors[0].ConflictIndex).To(BeNil())
+
+			Expect(validationErr.Errors[1].Index).To(Equal(2))
+			Expect(validationErr.Errors[1].Reason).To(ContainSubstring("overlaps ip_addresses[0]"))
+			Expect(*validationErr.Errors[1].ConflictIndex).To(Equal(0))
+
+			Expect(validationErr.Errors[2].Index).To(Equal(4))
+			Expect(validationErr.Errors[2].Reason).To(ContainSubstring("duplicates ip_addresses[3]"))
+			Expect(*validationErr.Errors[2].ConflictIndex).To(Equal(3))
+
+			Expect(validationErr.Errors[3].Index).To(Equal(5))
+			Expect(validationErr.Errors[3].Address).To(BeEmpty())
+
+			Expect(err.Error()).To(HavePrefix("invalid allowlist: ip_addresses[1]: "))
+		})
+	})
+	Describe(`AggregateAllowlist(ipAddresses []IpAddress)`, func() {
+		newDescribed := func(address string, description string) db2saasv1.IpAddress {
+			return db2saasv1.IpAddress{Address: core.StringPtr(address), Description: core.StringPtr(description)}
+		}
+		It(`Invoke AggregateAllowlist successfully`, func() {
+			aggregated, err := db2saasv1.AggregateAllowlist([]db2saasv1.IpAddress{
+				newDescribed("2001:db8:0:1::/64", "v6 b"),
+				newDescribed("10.0.1.0/24", "office b"),
+				newDescribed("10.0.0.0/24", "office a"),
+				newDescribed("10.0.0.17", "printer"),
+				newDescribed("10.0.2.0/24", "office c"),
+				newDescribed("10.0.3.0/24", "office a"),
+				newDescribed("10.0.5.0/24", ""),
+				newDescribed("10.0.6.0/24", "lab"),
+				newDescribed("2001:db8::/64", "v6 a"),
+				newDescribed("192.168.0.1", "host"),
+				newDescribed("192.168.0.1/32", "host"),
+				newDescribed("::ffff:10.9.0.1", "mapped"),
+			})
+			Expect(err).To(BeNil())
+			Expect(aggregated).To(Equal([]db2saasv1.IpAddress{
+				newDescribed("10.0.0.0/22", "office a; printer; office b; office c"),
+				newDescribed("10.0.5.0/24", ""),
+				newDescribed("10.0.6.0/24", "lab"),
+				newDescribed("192.168.0.1", "host"),
+				newDescribed("::ffff:10.9.0.1", "mapped"),
+				newDescribed("2001:db8::/63", "v6 a; v6 b"),
+			}))
+			Expect(db2saasv1.ValidateAllowlist(aggregated)).To(BeNil())
+
+			aggregated, err = db2saasv1.AggregateAllowlist([]db2saasv1.IpAddress{
+				newDescribed("0.0.0.0/1", "low"),
+				newDescribed("128.0.0.0/1", "high"),
+			})
+			Expect(err).To(BeNil())
+			Expect(aggregated).To(Equal([]db2saasv1.IpAddress{newDescribed("0.0.0.0/0", "low; high")}))
+
+			aggregated, err = db2saasv1.AggregateAllowlist(nil)
+			Expect(err).To(BeNil())
+			Expect(aggregated).To(BeEmpty())
+		})
+		It(`Invoke AggregateAllowlist with error`, func() {
+			_, err := db2saasv1.AggregateAllowlist([]db2saasv1.IpAddress{
+				newDescribed("10.0.0.0/24", "a"),
+				newDescribed("10.0.0.256", "b"),
+			})
+			var validationErr *db2saasv1.AllowlistValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Errors[0].Index).To(Equal(1))
+		})
+	})
+	Describe(`SuccessGetAllowlistIPs`, func() {
+		allowlist := &db2saasv1.SuccessGetAllowlistIPs{
+			IpAddresses: []db2saasv1.IpAddress{
+				newIpAddress("10.0.0.0/24"),
+				newIpAddress("10.0.0.7"),
+				newIpAddress("2001:db8::/48"),
+				newIpAddress("not an address"),
+			},
+		}
+		It(`Invoke Contains and Lookup successfully`, func() {
+			for ip, expected := range map[string]bool{
+				"10.0.0.1":           true,
+				"10.0.0.255":         true,
+				"10.0.1.0":           false,
+				"::ffff:10.0.0.9":    true,
+				"2001:db8:0:ffff::1": true,
+				"2001:db8:1::1":      false,
+				"fe80::1%eth0":       false,
+			} {
+				contains, err := allowlist.Contains(ip)
+				Expect(err).To(BeNil(), ip)
+				Expect(contains).To(Equal(expected), ip)
+			}
+
+			matches, err := allowlist.Lookup("10.0.0.7")
+			Expect(err).To(BeNil())
+			Expect(matches).To(Equal(allowlist.IpAddresses[:2]))
+		})
+		It(`Invoke Contains with error`, func() {
+			_, err := allowlist.Contains("10.0.0.0/24")
+			Expect(err).ToNot(BeNil())
+			_, err = allowlist.Contains("")
+			Expect(err).ToNot(BeNil())
+		})
+	})
+	Describe(`Client-side allowlist validation`, func() {
+		It(`Invoke NewIpAddress successfully`, func() {
+			db2saasService, err := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+				URL:           "http://db2saasv1modelgenerator.com",
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).To(BeNil())
+
+			// The address is kept as given; NormalizeIpAddress returns its canonical form.
+			ipAddressModel, err := db2saasService.NewIpAddress("2001:DB8::1/128", "office")
+			Expect(err).To(BeNil())
+			Expect(*ipAddressModel.Address).To(Equal("2001:DB8::1/128"))
+			normalized, err := db2saasv1.NormalizeIpAddress(*ipAddressModel.Address)
+			Expect(err).To(BeNil())
+			Expect(normalized).To(Equal("2001:db8::1"))
+
+			_, err = db2saasv1.NormalizeIpAddress("10.0.0.300/24")
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("10.0.0.300/24"))
+		})
+		It(`Invoke PostDb2SaasAllowlist with error: Allowlist validation`, func() {
+			fakeServer := db2saasfake.NewServer()
+			defer fakeServer.Close()
+			db2saasService, err := fakeServer.NewService()
+			Expect(err).To(BeNil())
+
+			postDb2SaasAllowlistOptionsModel := db2saasService.NewPostDb2SaasAllowlistOptions(fakeCRN, []db2saasv1.IpAddress{
+				newIpAddress("10.0.0.0/16"),
+				newIpAddress("10.0.42.0/24"),
+			})
+			result, response, err := db2saasService.PostDb2SaasAllowlist(postDb2SaasAllowlistOptionsModel)
+			Expect(err).ToNot(BeNil())
+			Expect(result).To(BeNil())
+			Expect(response).To(BeNil())
+
+			var validationErr *db2saasv1.AllowlistValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Errors[0].Index).To(Equal(1))
+			Expect(fakeServer.Calls(db2saasfake.RoutePostAllowlist)).To(BeZero())
+
+			Expect(db2saasService.GetValidateAllowlist()).To(BeTrue())
+			db2saasService.SetValidateAllowlist(false)
+			Expect(db2saasService.GetValidateAllowlist()).To(BeFalse())
+			_, _, err = db2saasService.PostDb2SaasAllowlist(postDb2SaasAllowlistOptionsModel)
+			Expect(err).To(BeNil())
+			Expect(fakeServer.Calls(db2saasfake.RoutePostAllowlist)).To(Equal(1))
+		})
+	})
+})
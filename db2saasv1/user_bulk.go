@@ -0,0 +1,355 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	common "github.com/IBM/cloud-db2-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Default settings used by BulkCreateUsers.
+const (
+	DefaultBulkConcurrency = 4
+	DefaultBulkMaxRetries  = 3
+)
+
+// Statuses of a BulkUserResult.
+const (
+	BulkUserResult_Status_Created = "created"
+	BulkUserResult_Status_Exists  = "exists"
+	BulkUserResult_Status_Failed  = "failed"
+	BulkUserResult_Status_Skipped = "skipped"
+)
+
+// BulkOptions : The settings of a bulk operation.
+type BulkOptions struct {
+	// The maximum number of users processed at the same time. Defaults to DefaultBulkConcurrency.
+	Concurrency int
+
+	// The maximum number of requests sent per second, across all users. Zero means no limit.
+	RequestsPerSecond float64
+
+	// The number of times a request that failed with a transient error is retried: a network error,
+	// a 429 or a 5xx response. Defaults to DefaultBulkMaxRetries; a negative value disables retries.
+	MaxRetries int
+
+	// The backoff used between retries of the same request.
+	Backoff *Backoff
+
+	// If false, the first failure stops the operation: users not started yet are reported as skipped.
+	// If true, every user is attempted.
+	ContinueOnError bool
+}
+
+// concurrency returns the effective number of workers for "n" users.
+func (bulkOptions *BulkOptions) concurrency(n int) int {
+	concurrency := bulkOptions.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBulkConcurrency
+	}
+	return max(1, min(concurrency, n))
+}
+
+// maxRetries returns the effective number of retries.
+func (bulkOptions *BulkOptions) maxRetries() int {
+	switch {
+	case bulkOptions.MaxRetries < 0:
+		return 0
+	case bulkOptions.MaxRetries == 0:
+		return DefaultBulkMaxRetries
+	}
+	return bulkOptions.MaxRetries
+}
+
+// BulkUserResult : The outcome of one user of a bulk operation.
+type BulkUserResult struct {
+	// The index of the user in the input.
+	Index int `json:"index"`
+
+	// The id of the user.
+	ID string `json:"id"`
+
+	// One of the BulkUserResult_Status_* constants.
+	Status string `json:"status"`
+
+	// The number of requests sent for the user, including retries.
+	Attempts int `json:"attempts"`
+
+	// The created user, if Status is "created".
+	User *SuccessUserResponse `json:"user,omitempty"`
+
+	// The user that already existed, if Status is "exists".
+	Existing *SuccessGetUserByID `json:"existing,omitempty"`
+
+	// Why the user failed, if Status is "failed".
+	Error error `json:"-"`
+}
+
+// BulkUsersError is returned by the bulk user operations when at least one user failed.
+type BulkUsersError struct {
+	// The results of the failed users, in input order.
+	Failed []*BulkUserResult
+
+	// The number of users in the input.
+	Total int
+}
+
+func (e *BulkUsersError) Error() string {
+	first := e.Failed[0]
+	return fmt.Sprintf("%d of %d users failed; user '%s': %s", len(e.Failed), e.Total, first.ID, first.Error.Error())
+}
+
+// rateLimiter spaces requests evenly.
+type rateLimiter struct {
+	mutex    sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64) *rateLimiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	return &rateLimiter{interval: time.Duration(float64(time.Second) / requestsPerSecond)}
+}
+
+// wait blocks until the next request may be sent. A nil limiter never blocks.
+func (limiter *rateLimiter) wait(ctx context.Context) error {
+	if limiter == nil {
+		return ctx.Err()
+	}
+	limiter.mutex.Lock()
+	now := time.Now()
+	at := limiter.next
+	if at.Before(now) {
+		at = now
+	}
+	limiter.next = at.Add(limiter.interval)
+	limiter.mutex.Unlock()
+
+	timer := time.NewTimer(time.Until(at))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// isTransient returns true if a request that failed with "response" and "err" may succeed when retried.
+func isTransient(ctx context.Context, response *core.DetailedResponse, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	if response == nil {
+		// The request was validated before being sent, so the error comes from the transport.
+		return true
+	}
+	return response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= 500
+}
+
+// retryRequests sends requests for one item of a bulk operation, applying the rate limit and retrying
+// transient failures.
+type retryRequests struct {
+	limiter  *rateLimiter
+	retries  int
+	backoff  *Backoff
+	attempts int
+}
+
+// do invokes "request" until it succeeds, fails with a non-transient error or runs out of retries.
+func (retry *retryRequests) do(ctx context.Context, request func() (*core.DetailedResponse, error)) (response *core.DetailedResponse, err error) {
+	interval, maxInterval, multiplier := retry.backoff.intervals()
+	for i := 0; ; i++ {
+		if err = retry.limiter.wait(ctx); err != nil {
+			return
+		}
+		retry.attempts++
+		response, err = request()
+		if i >= retry.retries || !isTransient(ctx, response, err) {
+			return
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		interval = min(time.Duration(float64(interval)*multiplier), maxInterval)
+	}
+}
+
+// BulkCreateUsers creates the users described by "users", processing up to bulkOptions.Concurrency users at
+// a time, and returns one result per user in input order.
+//
+// Creation is idempotent: each user is first looked up with GetbyidDb2SaasUser and is reported as "exists",
+// without being modified, if it is found. A 409 response to PostDb2SaasUser, e.g. when a retried request
+// had in fact succeeded, is handled the same way. Users whose options are invalid, or whose id repeats an
+// earlier one in the same deployment, fail without any request being sent; unless ContinueOnError is set,
+// no user is created then.
+//
+// The error is a *BulkUsersError if some users failed, or the context error if "ctx" ended first.
+func (db2saas *Db2saasV1) BulkCreateUsers(ctx context.Context, users []PostDb2SaasUserOptions, bulkOptions BulkOptions) (results []BulkUserResult, err error) {
+	results = make([]BulkUserResult, len(users))
+	ids := make(map[string]int, len(users))
+	invalid := false
+	for i := range users {
+		results[i] = BulkUserResult{Index: i, ID: core.StringNilMapper(users[i].ID), Status: BulkUserResult_Status_Skipped}
+		if validateErr := db2saas.validatePostDb2SaasUserOptions(&users[i]); validateErr != nil {
+			results[i].Status, results[i].Error, invalid = BulkUserResult_Status_Failed, validateErr, true
+			continue
+		}
+		key := decodeDeploymentID(*users[i].XDeploymentID) + "/" + *users[i].ID
+		if first, ok := ids[key]; ok {
+			duplicateErr := fmt.Errorf("user '%s' is also users[%d]", *users[i].ID, first)
+			results[i].Status, results[i].Error, invalid = BulkUserResult_Status_Failed, duplicateErr, true
+			continue
+		}
+		ids[key] = i
+	}
+
+	if !invalid || bulkOptions.ContinueOnError {
+		db2saas.runBulkCreateUsers(ctx, users, &bulkOptions, results)
+	}
+
+	bulkErr := &BulkUsersError{Total: len(users)}
+	for i := range results {
+		if results[i].Status == BulkUserResult_Status_Failed {
+			bulkErr.Failed = append(bulkErr.Failed, &results[i])
+		}
+	}
+	if len(bulkErr.Failed) > 0 {
+		err = core.SDKErrorf(bulkErr, "", "bulk-create-users-error", common.GetComponentInfo())
+	} else if ctx.Err() != nil {
+		err = core.SDKErrorf(ctx.Err(), "", "bulk-create-users-canceled", common.GetComponentInfo())
+	}
+	return
+}
+
+// runBulkCreateUsers creates the users whose result is still "skipped". Stopping on a failure only stops
+// dispatching users; the requests already in flight complete.
+func (db2saas *Db2saasV1) runBulkCreateUsers(ctx context.Context, users []PostDb2SaasUserOptions, bulkOptions *BulkOptions, results []BulkUserResult) {
+	dispatchCtx, stop := context.WithCancel(ctx)
+	defer stop()
+	limiter := newRateLimiter(bulkOptions.RequestsPerSecond)
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for n := bulkOptions.concurrency(len(users)); n > 0; n-- {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if dispatchCtx.Err() != nil {
+					continue
+				}
+				retry := &retryRequests{limiter: limiter, retries: bulkOptions.maxRetries(), backoff: bulkOptions.Backoff}
+				db2saas.createUser(ctx, &users[i], retry, &results[i])
+				results[i].Attempts = retry.attempts
+				if results[i].Status == BulkUserResult_Status_Failed && !bulkOptions.ContinueOnError {
+					stop()
+				}
+			}
+		}()
+	}
+	for i := range results {
+		if results[i].Status != BulkUserResult_Status_Skipped {
+			continue
+		}
+		select {
+		case indexes <- i:
+			continue
+		case <-dispatchCtx.Done():
+		}
+		break
+	}
+	close(indexes)
+	wg.Wait()
+}
+
+// createUser creates one user of BulkCreateUsers unless it exists, and records the outcome in "result".
+// A user left unfinished because the context ended stays "skipped".
+func (db2saas *Db2saasV1) createUser(ctx context.Context, options *PostDb2SaasUserOptions, retry *retryRequests, result *BulkUserResult) {
+	getOptions := &GetbyidDb2SaasUserOptions{
+		XDeploymentID: options.XDeploymentID,
+		ID:            options.ID,
+		Headers:       options.Headers,
+	}
+	lookup := func() (response *core.DetailedResponse, err error) {
+		result.Existing, response, err = db2saas.GetbyidDb2SaasUserWithContext(ctx, getOptions)
+		return
+	}
+	fail := func(err error) {
+		if ctx.Err() == nil {
+			result.Status, result.Error = BulkUserResult_Status_Failed, err
+		}
+	}
+
+	response, err := retry.do(ctx, lookup)
+	if err == nil {
+		result.Status = BulkUserResult_Status_Exists
+		return
+	}
+	if response == nil || response.StatusCode != http.StatusNotFound {
+		fail(core.RepurposeSDKProblem(err, "get-user-error"))
+		return
+	}
+
+	response, err = retry.do(ctx, func() (response *core.DetailedResponse, err error) {
+		result.User, response, err = db2saas.PostDb2SaasUserWithContext(ctx, options)
+		return
+	})
+	if err == nil {
+		result.Status = BulkUserResult_Status_Created
+		return
+	}
+	if response == nil || response.StatusCode != http.StatusConflict {
+		fail(core.RepurposeSDKProblem(err, "post-user-error"))
+		return
+	}
+	if _, err = retry.do(ctx, lookup); err != nil {
+		fail(core.RepurposeSDKProblem(err, "get-user-error"))
+		return
+	}
+	result.Status = BulkUserResult_Status_Exists
+}
+
+// validatePostDb2SaasUserOptions performs the checks PostDb2SaasUser makes before sending a request.
+func (db2saas *Db2saasV1) validatePostDb2SaasUserOptions(options *PostDb2SaasUserOptions) error {
+	err := core.ValidateStruct(options, "postDb2SaasUserOptions")
+	if err != nil {
+		return core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+	}
+	err = options.validateEnums()
+	if err != nil {
+		return core.SDKErrorf(err, "", "enum-validation-error", common.GetComponentInfo())
+	}
+	err = db2saas.validatePassword(options.Password, options.ID)
+	if err != nil {
+		return core.SDKErrorf(err, "", "password-policy-error", common.GetComponentInfo())
+	}
+	return nil
+}
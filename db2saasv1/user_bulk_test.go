@@ -0,0 +1,182 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/IBM/cloud-db2-go-sdk/db2saasfake"
+	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = describePasswordModes(`BulkCreateUsers`, func(passwords db2saasfake.PasswordMode) {
+	var fakeServer *db2saasfake.Server
+	var db2saasService *db2saasv1.Db2saasV1
+	BeforeEach(func() {
+		var err error
+		fakeServer = db2saasfake.NewServer()
+		fakeServer.Passwords = passwords
+		db2saasService, err = fakeServer.NewService()
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		fakeServer.Close()
+	})
+
+	newUser := func(crn string, id string) db2saasv1.PostDb2SaasUserOptions {
+		authentication, err := db2saasService.NewCreateUserAuthentication("internal", "Default")
+		Expect(err).To(BeNil())
+		return *db2saasService.NewPostDb2SaasUserOptions(crn, id, false, id+"-ibm-id", id,
+			"dEkMc43@gfAPl!867^dSbu", "bluuser", id+"@host.org", "no", authentication)
+	}
+	newUsers := func(n int) []db2saasv1.PostDb2SaasUserOptions {
+		users := make([]db2saasv1.PostDb2SaasUserOptions, n)
+		for i := range users {
+			users[i] = newUser(fakeCRN, fmt.Sprintf("user-%d", i))
+		}
+		return users
+	}
+	statuses := func(results []db2saasv1.BulkUserResult) []string {
+		statuses := make([]string, len(results))
+		for i := range results {
+			statuses[i] = results[i].Status
+		}
+		return statuses
+	}
+
+	It(`Invoke BulkCreateUsers successfully`, func() {
+		users := newUsers(6)
+		existing := users[2]
+		_, _, err := db2saasService.PostDb2SaasUser(&existing)
+		Expect(err).To(BeNil())
+
+		results, err := db2saasService.BulkCreateUsers(context.Background(), users, db2saasv1.BulkOptions{Concurrency: 3})
+		Expect(err).To(BeNil())
+		Expect(statuses(results)).To(Equal([]string{"created", "created", "exists", "created", "created", "created"}))
+		for i := range results {
+			Expect(results[i].Index).To(Equal(i))
+			Expect(results[i].ID).To(Equal(fmt.Sprintf("user-%d", i)))
+		}
+		Expect(*results[0].User.ID).To(Equal("user-0"))
+		Expect(results[0].Attempts).To(Equal(2))
+		Expect(*results[2].Existing.Email).To(Equal("user-2@host.org"))
+		Expect(results[2].Attempts).To(Equal(1))
+		Expect(fakeServer.UserIDs(fakeCRN)).To(HaveLen(6))
+
+		// Running it again creates nothing.
+		results, err = db2saasService.BulkCreateUsers(context.Background(), users, db2saasv1.BulkOptions{})
+		Expect(err).To(BeNil())
+		Expect(statuses(results)).To(HaveEach(db2saasv1.BulkUserResult_Status_Exists))
+		Expect(fakeServer.Calls(db2saasfake.RoutePostUser)).To(Equal(6))
+	})
+	It(`Invoke BulkCreateUsers successfully: Transient failures are retried`, func() {
+		fakeServer.InjectError(db2saasfake.RoutePostUser, db2saasfake.Fault{StatusCode: http.StatusServiceUnavailable, Message: "try again", Times: 2})
+		results, err := db2saasService.BulkCreateUsers(context.Background(), newUsers(1), db2saasv1.BulkOptions{Backoff: fastBackoff})
+		Expect(err).To(BeNil())
+		Expect(results[0].Status).To(Equal(db2saasv1.BulkUserResult_Status_Created))
+		Expect(results[0].Attempts).To(Equal(4))
+
+		fakeServer.InjectError(db2saasfake.RoutePostUser, db2saasfake.Fault{StatusCode: http.StatusTooManyRequests, Message: "slow down", Times: 2})
+		results, err = db2saasService.BulkCreateUsers(context.Background(), []db2saasv1.PostDb2SaasUserOptions{newUser(fakeCRN, "other")},
+			db2saasv1.BulkOptions{Backoff: fastBackoff, MaxRetries: 1})
+		Expect(err).ToNot(BeNil())
+		Expect(results[0].Status).To(Equal(db2saasv1.BulkUserResult_Status_Failed))
+		Expect(results[0].Attempts).To(Equal(3))
+	})
+	It(`Invoke BulkCreateUsers successfully: Users created concurrently`, func() {
+		users := newUsers(1)
+		_, _, err := db2saasService.PostDb2SaasUser(&users[0])
+		Expect(err).To(BeNil())
+		// The lookup misses the user, so the creation fails with 409.
+		fakeServer.InjectError(db2saasfake.RouteGetUserByID, db2saasfake.Fault{StatusCode: http.StatusNotFound, Message: "not found", Times: 1})
+
+		results, err := db2saasService.BulkCreateUsers(context.Background(), users, db2saasv1.BulkOptions{})
+		Expect(err).To(BeNil())
+		Expect(results[0].Status).To(Equal(db2saasv1.BulkUserResult_Status_Exists))
+		Expect(results[0].Existing).ToNot(BeNil())
+		Expect(results[0].Attempts).To(Equal(3))
+	})
+	It(`Invoke BulkCreateUsers successfully: Rate limit`, func() {
+		start := time.Now()
+		results, err := db2saasService.BulkCreateUsers(context.Background(), newUsers(3), db2saasv1.BulkOptions{RequestsPerSecond: 50, Concurrency: 3})
+		Expect(err).To(BeNil())
+		Expect(statuses(results)).To(HaveEach(db2saasv1.BulkUserResult_Status_Created))
+		// Six requests, 20ms apart.
+		Expect(time.Since(start)).To(BeNumerically(">=", 100*time.Millisecond))
+	})
+	It(`Invoke BulkCreateUsers through an instance handle`, func() {
+		instance, err := db2saasService.Instance(fakeCRN)
+		Expect(err).To(BeNil())
+		users := []db2saasv1.PostDb2SaasUserOptions{newUser("", "user-0"), newUser(fakeProfile, "user-1")}
+		results, err := instance.Users().BulkCreate(users, db2saasv1.BulkOptions{})
+		Expect(err).To(BeNil())
+		Expect(statuses(results)).To(Equal([]string{"created", "created"}))
+		Expect(*users[0].XDeploymentID).To(BeEmpty())
+		Expect(fakeServer.UserIDs(fakeCRN)).To(Equal([]string{"user-0", "user-1"}))
+	})
+	It(`Invoke BulkCreateUsers with error: Stop on first error`, func() {
+		fakeServer.InjectError(db2saasfake.RoutePostUser, db2saasfake.Fault{StatusCode: http.StatusBadRequest, Message: "rejected", Times: 1})
+		results, err := db2saasService.BulkCreateUsers(context.Background(), newUsers(4), db2saasv1.BulkOptions{Concurrency: 1})
+		Expect(err).ToNot(BeNil())
+		Expect(statuses(results)).To(Equal([]string{"failed", "skipped", "skipped", "skipped"}))
+		Expect(results[0].Attempts).To(Equal(2))
+
+		var bulkErr *db2saasv1.BulkUsersError
+		Expect(errors.As(err, &bulkErr)).To(BeTrue())
+		Expect(bulkErr.Total).To(Equal(4))
+		Expect(bulkErr.Failed).To(HaveLen(1))
+		Expect(bulkErr.Failed[0].ID).To(Equal("user-0"))
+		Expect(err.Error()).To(ContainSubstring("rejected"))
+		Expect(fakeServer.UserIDs(fakeCRN)).To(BeEmpty())
+	})
+	It(`Invoke BulkCreateUsers with error: Continue on error`, func() {
+		users := newUsers(4)
+		users[1].SetRole("admin")
+		users[3] = newUser(fakeProfile, "user-0")
+
+		results, err := db2saasService.BulkCreateUsers(context.Background(), users, db2saasv1.BulkOptions{})
+		Expect(err).ToNot(BeNil())
+		Expect(statuses(results)).To(Equal([]string{"skipped", "failed", "skipped", "failed"}))
+		Expect(results[3].Error.Error()).To(ContainSubstring("is also users[0]"))
+		Expect(fakeServer.Calls(db2saasfake.RouteGetUserByID)).To(BeZero())
+
+		results, err = db2saasService.BulkCreateUsers(context.Background(), users, db2saasv1.BulkOptions{ContinueOnError: true})
+		Expect(err).ToNot(BeNil())
+		Expect(statuses(results)).To(Equal([]string{"created", "failed", "created", "failed"}))
+		Expect(results[1].Attempts).To(BeZero())
+		Expect(fakeServer.UserIDs(fakeCRN)).To(Equal([]string{"user-0", "user-2"}))
+
+		var bulkErr *db2saasv1.BulkUsersError
+		Expect(errors.As(err, &bulkErr)).To(BeTrue())
+		Expect(bulkErr.Failed).To(HaveLen(2))
+		Expect(err.Error()).To(HavePrefix("2 of 4 users failed; user 'user-1': "))
+	})
+	It(`Invoke BulkCreateUsers with error: Canceled context`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		results, err := db2saasService.BulkCreateUsers(ctx, newUsers(2), db2saasv1.BulkOptions{})
+		Expect(err).ToNot(BeNil())
+		Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+		Expect(statuses(results)).To(HaveEach(db2saasv1.BulkUserResult_Status_Skipped))
+	})
+})
@@ -0,0 +1,267 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+
+	common "github.com/IBM/cloud-db2-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Character classes of the passwords checked by PasswordPolicy. PasswordSpecialCharacters are the special
+// characters of the passwords generated by a policy that does not restrict them.
+const (
+	PasswordUppercaseCharacters = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	PasswordLowercaseCharacters = "abcdefghijklmnopqrstuvwxyz"
+	PasswordDigitCharacters     = "0123456789"
+	PasswordSpecialCharacters   = "!@#$%^&*()-_=+[]{}:,.?~"
+)
+
+// DefaultGeneratedPasswordLength is the length of the passwords generated by PasswordPolicy.Generate, unless
+// the policy requires longer or shorter ones.
+const DefaultGeneratedPasswordLength = 24
+
+// PasswordPolicy : The rules that the password of a database user must follow. The zero value of a property
+// disables its rule.
+type PasswordPolicy struct {
+	// The minimum number of characters.
+	MinLength int
+
+	// The maximum number of characters.
+	MaxLength int
+
+	// The minimum number of uppercase letters.
+	MinUppercase int
+
+	// The minimum number of lowercase letters.
+	MinLowercase int
+
+	// The minimum number of digits.
+	MinDigits int
+
+	// The minimum number of special characters.
+	MinSpecial int
+
+	// The special characters allowed in passwords. When set, any other character that is not a letter or a
+	// digit is rejected. When empty, any character that is not a letter or a digit is a special character.
+	SpecialCharacters string
+
+	// Whether the password may contain the id of the user, ignoring case.
+	AllowUserID bool
+}
+
+// DefaultPasswordPolicy returns a policy for strong passwords: 15 to 32 characters, with at least one
+// uppercase letter, one lowercase letter, one digit and one special character of any kind, and not containing
+// the user id. It is not the policy of the service, which checks passwords on its own and is the authority on
+// which passwords it accepts; it is the policy of the passwords generated by
+// NewPostDb2SaasUserOptionsWithGeneratedPassword when the client has none.
+func DefaultPasswordPolicy() *PasswordPolicy {
+	return &PasswordPolicy{
+		MinLength:    15,
+		MaxLength:    32,
+		MinUppercase: 1,
+		MinLowercase: 1,
+		MinDigits:    1,
+		MinSpecial:   1,
+	}
+}
+
+// generatedSpecialCharacters returns the special characters of the passwords generated by the policy.
+func (policy *PasswordPolicy) generatedSpecialCharacters() string {
+	if policy.SpecialCharacters == "" {
+		return PasswordSpecialCharacters
+	}
+	return policy.SpecialCharacters
+}
+
+// Validate returns an error listing the rules of the policy that the password of the user "userID" breaks.
+func (policy *PasswordPolicy) Validate(password string, userID string) error {
+	var uppercase, lowercase, digits, special int
+	var invalid []string
+	for _, c := range password {
+		switch {
+		case strings.ContainsRune(PasswordUppercaseCharacters, c):
+			uppercase++
+		case strings.ContainsRune(PasswordLowercaseCharacters, c):
+			lowercase++
+		case strings.ContainsRune(PasswordDigitCharacters, c):
+			digits++
+		case policy.SpecialCharacters == "" || strings.ContainsRune(policy.SpecialCharacters, c):
+			special++
+		default:
+			if !containsString(invalid, string(c)) {
+				invalid = append(invalid, string(c))
+			}
+		}
+	}
+
+	var violations []string
+	length := len([]rune(password))
+	if length < policy.MinLength {
+		violations = append(violations, fmt.Sprintf("at least %d characters", policy.MinLength))
+	}
+	if policy.MaxLength > 0 && length > policy.MaxLength {
+		violations = append(violations, fmt.Sprintf("at most %d characters", policy.MaxLength))
+	}
+	for _, class := range []struct {
+		count, min int
+		name       string
+	}{
+		{uppercase, policy.MinUppercase, "uppercase letter"},
+		{lowercase, policy.MinLowercase, "lowercase letter"},
+		{digits, policy.MinDigits, "digit"},
+		{special, policy.MinSpecial, "special character"},
+	} {
+		if class.count < class.min {
+			violations = append(violations, fmt.Sprintf("at least %d %s(s)", class.min, class.name))
+		}
+	}
+	if len(invalid) > 0 {
+		violations = append(violations, fmt.Sprintf("no character '%s' (special characters are '%s')",
+			strings.Join(invalid, "', '"), policy.SpecialCharacters))
+	}
+	if !policy.AllowUserID && userID != "" && strings.Contains(strings.ToLower(password), strings.ToLower(userID)) {
+		violations = append(violations, fmt.Sprintf("not containing the user id '%s'", userID))
+	}
+	if len(violations) > 0 {
+		return fmt.Errorf("the password does not satisfy the password policy, it requires %s", strings.Join(violations, ", "))
+	}
+	return nil
+}
+
+// Generate returns a random password of the user "userID" that satisfies the policy. The password is made of
+// DefaultGeneratedPasswordLength characters, or of the minimum or maximum length of the policy if the default
+// length is out of its bounds, drawn from crypto/rand.
+func (policy *PasswordPolicy) Generate(userID string) (string, error) {
+	length := max(DefaultGeneratedPasswordLength, policy.MinLength)
+	if policy.MaxLength > 0 {
+		length = min(length, policy.MaxLength)
+	}
+	classes := []struct {
+		characters string
+		min        int
+	}{
+		{PasswordUppercaseCharacters, policy.MinUppercase},
+		{PasswordLowercaseCharacters, policy.MinLowercase},
+		{PasswordDigitCharacters, policy.MinDigits},
+		{policy.generatedSpecialCharacters(), policy.MinSpecial},
+	}
+	required := 0
+	for _, class := range classes {
+		required += max(class.min, 0)
+	}
+	if required > length {
+		return "", core.SDKErrorf(nil, fmt.Sprintf("the password policy requires %d characters of given classes in at most %d characters", required, length),
+			"invalid-password-policy", common.GetComponentInfo())
+	}
+
+	all := PasswordUppercaseCharacters + PasswordLowercaseCharacters + PasswordDigitCharacters + policy.generatedSpecialCharacters()
+	// A password containing the user id is drawn again; with random characters, this is rare unless the id is
+	// very short.
+	for attempt := 0; attempt < 100; attempt++ {
+		password := make([]byte, 0, length)
+		for _, class := range classes {
+			for i := 0; i < class.min; i++ {
+				c, err := randomCharacter(class.characters)
+				if err != nil {
+					return "", err
+				}
+				password = append(password, c)
+			}
+		}
+		for len(password) < length {
+			c, err := randomCharacter(all)
+			if err != nil {
+				return "", err
+			}
+			password = append(password, c)
+		}
+		// Shuffle, so that the characters of each class are not at fixed positions.
+		for i := len(password) - 1; i > 0; i-- {
+			j, err := randomInt(i + 1)
+			if err != nil {
+				return "", err
+			}
+			password[i], password[j] = password[j], password[i]
+		}
+		if policy.Validate(string(password), userID) == nil {
+			return string(password), nil
+		}
+	}
+	return "", core.SDKErrorf(nil, fmt.Sprintf("could not generate a password satisfying the password policy for user '%s'", userID),
+		"password-generation-error", common.GetComponentInfo())
+}
+
+// randomCharacter returns one of "characters", which are ASCII, chosen uniformly with crypto/rand.
+func randomCharacter(characters string) (byte, error) {
+	i, err := randomInt(len(characters))
+	if err != nil {
+		return 0, err
+	}
+	return characters[i], nil
+}
+
+func randomInt(n int) (int, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, core.SDKErrorf(err, "", "random-error", common.GetComponentInfo())
+	}
+	return int(i.Int64()), nil
+}
+
+// SetPasswordPolicy sets the policy that the passwords of new users, and the new passwords of
+// UpdateDb2SaasUser, are checked against before any request is sent, e.g. DefaultPasswordPolicy or the
+// policy of an organization. The client checks no password until it is set: passwords are left to the
+// service, as is the case again after setting a nil policy.
+func (db2saas *Db2saasV1) SetPasswordPolicy(policy *PasswordPolicy) {
+	db2saas.passwordPolicy = policy
+}
+
+// GetPasswordPolicy returns the policy that passwords are checked against, or nil if the check is disabled.
+func (db2saas *Db2saasV1) GetPasswordPolicy() *PasswordPolicy {
+	return db2saas.passwordPolicy
+}
+
+// validatePassword checks the password of the user "userID" against the password policy of the client.
+func (db2saas *Db2saasV1) validatePassword(password *string, userID *string) error {
+	policy := db2saas.GetPasswordPolicy()
+	if policy == nil || password == nil {
+		return nil
+	}
+	return policy.Validate(*password, core.StringNilMapper(userID))
+}
+
+// NewPostDb2SaasUserOptionsWithGeneratedPassword : Instantiate PostDb2SaasUserOptions with a password generated
+// by the password policy of the client, or by DefaultPasswordPolicy if the client does not check passwords.
+// The password is returned, and can't be read back from the service once redacted, so keep it now.
+func (db2saas *Db2saasV1) NewPostDb2SaasUserOptionsWithGeneratedPassword(xDeploymentID string, id string, iam bool, ibmid string, name string, role string, email string, locked string, authentication *CreateUserAuthentication) (options *PostDb2SaasUserOptions, password string, err error) {
+	policy := db2saas.GetPasswordPolicy()
+	if policy == nil {
+		policy = DefaultPasswordPolicy()
+	}
+	password, err = policy.Generate(id)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "password-generation-error")
+		return
+	}
+	options = db2saas.NewPostDb2SaasUserOptions(xDeploymentID, id, iam, ibmid, name, password, role, email, locked, authentication)
+	return
+}
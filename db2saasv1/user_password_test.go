@@ -0,0 +1,180 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1_test
+
+import (
+	"strings"
+
+	"github.com/IBM/cloud-db2-go-sdk/db2saasfake"
+	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Password policy`, func() {
+	Describe(`Validate(password string, userID string)`, func() {
+		policy := db2saasv1.DefaultPasswordPolicy()
+		It(`Invoke Validate successfully`, func() {
+			Expect(policy.Validate("dEkMc43@gfAPl!867^dSbu", "test-user")).To(BeNil())
+			Expect(policy.Validate("Aa1!Aa1!Aa1!Aa1", "bob")).To(BeNil())
+			Expect(policy.Validate("dEkMc43 gfAPl\"867§dSbu", "test-user")).To(BeNil())
+		})
+		It(`Invoke Validate with error`, func() {
+			for password, violation := range map[string]string{
+				"Aa1!":                           "at least 15 characters",
+				"Aa1!" + strings.Repeat("a", 29): "at most 32 characters",
+				"dekmc43@gfapl!867^dsbu":         "at least 1 uppercase letter(s)",
+				"DEKMC43@GFAPL!867^DSBU":         "at least 1 lowercase letter(s)",
+				"dEkMcXY@gfAPl!abc^dSbu":         "at least 1 digit(s)",
+				"dEkMc43gfAPl867dSbuXYZ":         "at least 1 special character(s)",
+				"dEkMc43@gfAPl!867^Test-User":    "not containing the user id 'test-user'",
+			} {
+				err := policy.Validate(password, "test-user")
+				Expect(err).ToNot(BeNil(), password)
+				Expect(err.Error()).To(ContainSubstring(violation), password)
+			}
+
+			err := policy.Validate("password", "")
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(Equal("the password does not satisfy the password policy, it requires at least 15 characters, " +
+				"at least 1 uppercase letter(s), at least 1 digit(s), at least 1 special character(s)"))
+
+			restricted := &db2saasv1.PasswordPolicy{SpecialCharacters: db2saasv1.PasswordSpecialCharacters}
+			err = restricted.Validate("dEkMc43@gfAPl 867\"dSbu", "test-user")
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(`no character ' ', '"'`))
+
+			allowed := &db2saasv1.PasswordPolicy{AllowUserID: true, SpecialCharacters: "#"}
+			Expect(allowed.Validate("test-user", "test-user")).ToNot(BeNil())
+			Expect(allowed.Validate("test#user", "test")).To(BeNil())
+		})
+	})
+	Describe(`Generate(userID string)`, func() {
+		It(`Invoke Generate successfully`, func() {
+			policy := db2saasv1.DefaultPasswordPolicy()
+			seen := map[string]bool{}
+			for i := 0; i < 50; i++ {
+				password, err := policy.Generate("ab")
+				Expect(err).To(BeNil())
+				Expect(password).To(HaveLen(db2saasv1.DefaultGeneratedPasswordLength))
+				Expect(policy.Validate(password, "ab")).To(BeNil())
+				Expect(seen).ToNot(HaveKey(password))
+				seen[password] = true
+			}
+
+			policy = &db2saasv1.PasswordPolicy{MinLength: 40, MinDigits: 10, SpecialCharacters: "#"}
+			password, err := policy.Generate("")
+			Expect(err).To(BeNil())
+			Expect(password).To(HaveLen(40))
+			Expect(policy.Validate(password, "")).To(BeNil())
+
+			policy = &db2saasv1.PasswordPolicy{MaxLength: 8, MinUppercase: 8}
+			password, err = policy.Generate("")
+			Expect(err).To(BeNil())
+			Expect(password).To(MatchRegexp(`^[A-Z]{8}$`))
+		})
+		It(`Invoke Generate with error`, func() {
+			_, err := (&db2saasv1.PasswordPolicy{MaxLength: 4, MinDigits: 3, MinSpecial: 3}).Generate("")
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("requires 6 characters of given classes in at most 4 characters"))
+		})
+	})
+	Describe(`Client password policy`, func() {
+		var fakeServer *db2saasfake.Server
+		var db2saasService *db2saasv1.Db2saasV1
+		var authentication *db2saasv1.CreateUserAuthentication
+		BeforeEach(func() {
+			var err error
+			fakeServer = db2saasfake.NewServer()
+			db2saasService, err = fakeServer.NewService()
+			Expect(err).To(BeNil())
+			authentication, err = db2saasService.NewCreateUserAuthentication("internal", "Default")
+			Expect(err).To(BeNil())
+		})
+		AfterEach(func() {
+			fakeServer.Close()
+		})
+
+		It(`Invoke NewPostDb2SaasUserOptionsWithGeneratedPassword successfully`, func() {
+			postDb2SaasUserOptionsModel, password, err := db2saasService.NewPostDb2SaasUserOptionsWithGeneratedPassword(fakeCRN, "test-user",
+				false, "test-ibm-id", "Test User", "bluuser", "test@host.org", "no", authentication)
+			Expect(err).To(BeNil())
+			Expect(*postDb2SaasUserOptionsModel.Password).To(Equal(password))
+			Expect(db2saasv1.DefaultPasswordPolicy().Validate(password, "test-user")).To(BeNil())
+
+			_, _, err = db2saasService.PostDb2SaasUser(postDb2SaasUserOptionsModel)
+			Expect(err).To(BeNil())
+			Expect(fakeServer.UserIDs(fakeCRN)).To(Equal([]string{"test-user"}))
+
+			db2saasService.SetPasswordPolicy(&db2saasv1.PasswordPolicy{MinLength: 30, MaxLength: 30})
+			postDb2SaasUserOptionsModel, password, err = db2saasService.NewPostDb2SaasUserOptionsWithGeneratedPassword(fakeCRN, "other-user",
+				false, "other-ibm-id", "Other User", "bluuser", "other@host.org", "no", authentication)
+			Expect(err).To(BeNil())
+			Expect(password).To(HaveLen(30))
+		})
+		It(`Invoke PostDb2SaasUser successfully: No policy by default`, func() {
+			Expect(db2saasService.GetPasswordPolicy()).To(BeNil())
+			_, _, err := db2saasService.PostDb2SaasUser(db2saasService.NewPostDb2SaasUserOptions(fakeCRN, "test-user", false, "test-ibm-id",
+				"Test User", "password", "bluuser", "test@host.org", "no", authentication))
+			Expect(err).To(BeNil())
+			_, err = db2saasService.SyncUsers(db2saasService.NewSyncUsersOptions(fakeCRN, []db2saasv1.DesiredUser{
+				{ID: "test-user"}, {ID: "bob", Password: "bob"},
+			}))
+			Expect(err).To(BeNil())
+			Expect(fakeServer.UserIDs(fakeCRN)).To(Equal([]string{"bob", "test-user"}))
+		})
+		It(`Invoke PostDb2SaasUser with error: Weak password`, func() {
+			db2saasService.SetPasswordPolicy(db2saasv1.DefaultPasswordPolicy())
+			Expect(db2saasService.GetPasswordPolicy()).To(Equal(db2saasv1.DefaultPasswordPolicy()))
+			postDb2SaasUserOptionsModel := db2saasService.NewPostDb2SaasUserOptions(fakeCRN, "test-user", false, "test-ibm-id",
+				"Test User", "password", "bluuser", "test@host.org", "no", authentication)
+			_, _, err := db2saasService.PostDb2SaasUser(postDb2SaasUserOptionsModel)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("at least 15 characters"))
+			Expect(fakeServer.Calls(db2saasfake.RoutePostUser)).To(BeZero())
+
+			_, err = db2saasService.SyncUsers(db2saasService.NewSyncUsersOptions(fakeCRN, []db2saasv1.DesiredUser{
+				{ID: "alice", Password: "dEkMc43@gfAPl!867^dSbu"}, {ID: "bob", Password: "bob-Password-123"},
+			}))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("users[1]: the password does not satisfy the password policy, it requires not containing the user id 'bob'"))
+			Expect(fakeServer.Calls(db2saasfake.RouteGetUsers)).To(BeZero())
+
+			// Without a policy, the password is left to the service.
+			db2saasService.SetPasswordPolicy(nil)
+			Expect(db2saasService.GetPasswordPolicy()).To(BeNil())
+			_, _, err = db2saasService.PostDb2SaasUser(postDb2SaasUserOptionsModel)
+			Expect(err).To(BeNil())
+		})
+		It(`Invoke UpdateDb2SaasUser with error: Weak password`, func() {
+			db2saasService.SetPasswordPolicy(db2saasv1.DefaultPasswordPolicy())
+			_, _, err := db2saasService.PostDb2SaasUser(db2saasService.NewPostDb2SaasUserOptions(fakeCRN, "test-user", false, "test-ibm-id",
+				"Test User", "dEkMc43@gfAPl!867^dSbu", "bluuser", "test@host.org", "no", authentication))
+			Expect(err).To(BeNil())
+
+			_, _, err = db2saasService.UpdateDb2SaasUser(db2saasService.NewUpdateDb2SaasUserOptions(fakeCRN, "test-user").
+				SetNewID("renamed").SetNewPassword("dEkMc43@RENAMED!867^"))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("not containing the user id 'renamed'"))
+			Expect(fakeServer.Calls(db2saasfake.RouteGetUserByID)).To(BeZero())
+
+			// Other properties can be updated, whatever the current password.
+			_, _, err = db2saasService.UpdateDb2SaasUser(db2saasService.NewUpdateDb2SaasUserOptions(fakeCRN, "test-user").SetNewEmail("new@host.org"))
+			Expect(err).To(BeNil())
+		})
+	})
+})
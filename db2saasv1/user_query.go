@@ -0,0 +1,364 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	common "github.com/IBM/cloud-db2-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultUsersPageLimit is the number of users per page of a UsersPager when QueryUsersOptions.Limit is not set.
+const DefaultUsersPageLimit = 100
+
+// UserFilter selects users on the client side. Zero-valued fields do not restrict the selection.
+type UserFilter struct {
+	// Only select users with one of these roles.
+	Roles []UserRole
+
+	// Only select locked users if true, or unlocked users if false.
+	Locked *bool
+
+	// Only select IAM users if true, or IBMid users if false.
+	Iam *bool
+
+	// Only select users whose email is in one of these domains, such as "example.org". Domains are compared
+	// ignoring case, and subdomains do not match.
+	EmailDomains []string
+
+	// Only select users whose id, name or email contains this text, ignoring case.
+	Search string
+}
+
+// Matches returns true if the user satisfies every criterion of the filter.
+func (filter *UserFilter) Matches(user *SuccessGetUserInfoResourcesItem) bool {
+	if filter == nil {
+		return true
+	}
+	if len(filter.Roles) > 0 && !containsUserRole(filter.Roles, user.UserRole()) {
+		return false
+	}
+	if filter.Locked != nil && (user.Locked == nil || user.IsLocked() != *filter.Locked) {
+		return false
+	}
+	if filter.Iam != nil && (user.Iam == nil || *user.Iam != *filter.Iam) {
+		return false
+	}
+	if len(filter.EmailDomains) > 0 && !matchesEmailDomain(core.StringNilMapper(user.Email), filter.EmailDomains) {
+		return false
+	}
+	if filter.Search != "" {
+		search := strings.ToLower(filter.Search)
+		found := false
+		for _, value := range []*string{user.ID, user.Name, user.Email} {
+			if value != nil && strings.Contains(strings.ToLower(*value), search) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func containsUserRole(roles []UserRole, role UserRole) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesEmailDomain returns true if "email" is in one of "domains", which may start with "@".
+func matchesEmailDomain(email string, domains []string) bool {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	for _, domain := range domains {
+		if strings.EqualFold(email[at+1:], strings.TrimPrefix(domain, "@")) {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterUsers returns the users that match the filter, preserving their order.
+func FilterUsers(users []SuccessGetUserInfoResourcesItem, filter *UserFilter) []SuccessGetUserInfoResourcesItem {
+	result := []SuccessGetUserInfoResourcesItem{}
+	for i := range users {
+		if filter.Matches(&users[i]) {
+			result = append(result, users[i])
+		}
+	}
+	return result
+}
+
+// Constants associated with the QueryUsersOptions.Sort property.
+// The order of the returned users.
+const (
+	QueryUsersOptions_Sort_Email     = "email"
+	QueryUsersOptions_Sort_EmailDesc = "-email"
+	QueryUsersOptions_Sort_ID        = "id"
+	QueryUsersOptions_Sort_IDDesc    = "-id"
+	QueryUsersOptions_Sort_Name      = "name"
+	QueryUsersOptions_Sort_NameDesc  = "-name"
+	QueryUsersOptions_Sort_Role      = "role"
+	QueryUsersOptions_Sort_RoleDesc  = "-role"
+)
+
+var userSortKeys = map[string]func(*SuccessGetUserInfoResourcesItem) *string{
+	QueryUsersOptions_Sort_Email: func(user *SuccessGetUserInfoResourcesItem) *string { return user.Email },
+	QueryUsersOptions_Sort_ID:    func(user *SuccessGetUserInfoResourcesItem) *string { return user.ID },
+	QueryUsersOptions_Sort_Name:  func(user *SuccessGetUserInfoResourcesItem) *string { return user.Name },
+	QueryUsersOptions_Sort_Role:  func(user *SuccessGetUserInfoResourcesItem) *string { return user.Role },
+}
+
+// SortUsers stably sorts users in place on one of the QueryUsersOptions_Sort_* orders; a "-" prefix sorts in
+// descending order. Values are compared ignoring case, and users without a value are placed last.
+func SortUsers(users []SuccessGetUserInfoResourcesItem, order string) error {
+	key, ok := userSortKeys[strings.TrimPrefix(order, "-")]
+	if !ok {
+		return core.SDKErrorf(enumValueError("sort", order, QueryUsersOptions_Sort_Email, QueryUsersOptions_Sort_EmailDesc,
+			QueryUsersOptions_Sort_ID, QueryUsersOptions_Sort_IDDesc, QueryUsersOptions_Sort_Name, QueryUsersOptions_Sort_NameDesc,
+			QueryUsersOptions_Sort_Role, QueryUsersOptions_Sort_RoleDesc), "", "invalid-user-sort", common.GetComponentInfo())
+	}
+	descending := strings.HasPrefix(order, "-")
+	sort.SliceStable(users, func(i, j int) bool {
+		a, b := key(&users[i]), key(&users[j])
+		if a == nil || b == nil {
+			return a != nil && b == nil
+		}
+		if descending {
+			return strings.ToLower(*a) > strings.ToLower(*b)
+		}
+		return strings.ToLower(*a) < strings.ToLower(*b)
+	})
+	return nil
+}
+
+// QueryUsersOptions : The QueryUsers options.
+type QueryUsersOptions struct {
+	// CRN deployment id.
+	XDeploymentID *string `json:"x-deployment-id" validate:"required"`
+
+	// Only return the users selected by this filter.
+	Filter *UserFilter
+
+	// The order of the returned users. By default, users are returned in the order of the service.
+	Sort *string
+
+	// The maximum number of users per page of a UsersPager. Defaults to DefaultUsersPageLimit.
+	Limit *int64
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewQueryUsersOptions : Instantiate QueryUsersOptions
+func (*Db2saasV1) NewQueryUsersOptions(xDeploymentID string) *QueryUsersOptions {
+	return &QueryUsersOptions{
+		XDeploymentID: core.StringPtr(xDeploymentID),
+	}
+}
+
+// NewQueryUsersOptionsForDeployment : Instantiate QueryUsersOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewQueryUsersOptionsForDeployment(ref *DeploymentRef) *QueryUsersOptions {
+	return db2saas.NewQueryUsersOptions(ref.CRN())
+}
+
+// SetXDeploymentID : Allow user to set XDeploymentID
+func (_options *QueryUsersOptions) SetXDeploymentID(xDeploymentID string) *QueryUsersOptions {
+	_options.XDeploymentID = core.StringPtr(xDeploymentID)
+	return _options
+}
+
+// SetDeployment : Allow user to set XDeploymentID from a DeploymentRef
+func (_options *QueryUsersOptions) SetDeployment(ref *DeploymentRef) *QueryUsersOptions {
+	_options.XDeploymentID = core.StringPtr(ref.CRN())
+	return _options
+}
+
+// SetFilter : Allow user to set Filter
+func (_options *QueryUsersOptions) SetFilter(filter *UserFilter) *QueryUsersOptions {
+	_options.Filter = filter
+	return _options
+}
+
+// SetSort : Allow user to set Sort
+func (_options *QueryUsersOptions) SetSort(sort string) *QueryUsersOptions {
+	_options.Sort = core.StringPtr(sort)
+	return _options
+}
+
+// SetLimit : Allow user to set Limit
+func (_options *QueryUsersOptions) SetLimit(limit int64) *QueryUsersOptions {
+	_options.Limit = core.Int64Ptr(limit)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *QueryUsersOptions) SetHeaders(param map[string]string) *QueryUsersOptions {
+	options.Headers = param
+	return options
+}
+
+// QueryUsers : Search the users
+// Get the users of the deployment that match a filter, in the requested order. The service returns every user
+// at once, so the filter and the order are applied on the client.
+func (db2saas *Db2saasV1) QueryUsers(queryUsersOptions *QueryUsersOptions) (result []SuccessGetUserInfoResourcesItem, response *core.DetailedResponse, err error) {
+	result, response, err = db2saas.QueryUsersWithContext(context.Background(), queryUsersOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// QueryUsersWithContext is an alternate form of the QueryUsers method which supports a Context parameter
+func (db2saas *Db2saasV1) QueryUsersWithContext(ctx context.Context, queryUsersOptions *QueryUsersOptions) (result []SuccessGetUserInfoResourcesItem, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(queryUsersOptions, "queryUsersOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(queryUsersOptions, "queryUsersOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	if queryUsersOptions.Sort != nil {
+		// Checks the order before sending the request.
+		err = SortUsers(nil, *queryUsersOptions.Sort)
+		if err != nil {
+			return
+		}
+	}
+
+	users, response, err := db2saas.GetDb2SaasUserWithContext(ctx, &GetDb2SaasUserOptions{
+		XDeploymentID: queryUsersOptions.XDeploymentID,
+		Headers:       queryUsersOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-users-error")
+		return
+	}
+	result = FilterUsers(users.Resources, queryUsersOptions.Filter)
+	if queryUsersOptions.Sort != nil {
+		err = SortUsers(result, *queryUsersOptions.Sort)
+	}
+	return
+}
+
+// UsersPager can be used to page through the users returned by QueryUsers.
+//
+// The service returns every user in a single response, so the first page fetches them all and the pages are
+// cut on the client. Callers only depend on HasNext and GetNext, which keep their meaning once the service
+// pages users.
+type UsersPager struct {
+	hasNext bool
+	options *QueryUsersOptions
+	client  *Db2saasV1
+	users   []SuccessGetUserInfoResourcesItem
+	fetched bool
+	offset  int
+}
+
+// NewUsersPager returns a new UsersPager instance.
+func (db2saas *Db2saasV1) NewUsersPager(options *QueryUsersOptions) (pager *UsersPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if options.Limit != nil && *options.Limit <= 0 {
+		err = core.SDKErrorf(nil, "the 'options.Limit' field must be positive", "invalid-limit", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy QueryUsersOptions = *options
+	pager = &UsersPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  db2saas,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *UsersPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *UsersPager) GetNextWithContext(ctx context.Context) (page []SuccessGetUserInfoResourcesItem, err error) {
+	if !pager.HasNext() {
+		return nil, core.SDKErrorf(nil, "no more results available", "no-more-results", common.GetComponentInfo())
+	}
+
+	if !pager.fetched {
+		pager.users, _, err = pager.client.QueryUsersWithContext(ctx, pager.options)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		pager.fetched = true
+	}
+
+	limit := int64(DefaultUsersPageLimit)
+	if pager.options.Limit != nil {
+		limit = *pager.options.Limit
+	}
+	end := min(pager.offset+int(limit), len(pager.users))
+	page = pager.users[pager.offset:end:end]
+	pager.offset = end
+	pager.hasNext = pager.offset < len(pager.users)
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *UsersPager) GetAllWithContext(ctx context.Context) (allItems []SuccessGetUserInfoResourcesItem, err error) {
+	for pager.HasNext() {
+		var nextPage []SuccessGetUserInfoResourcesItem
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *UsersPager) GetNext() (page []SuccessGetUserInfoResourcesItem, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *UsersPager) GetAll() (allItems []SuccessGetUserInfoResourcesItem, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
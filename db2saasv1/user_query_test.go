@@ -0,0 +1,177 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/cloud-db2-go-sdk/db2saasfake"
+	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = describePasswordModes(`QueryUsers`, func(passwords db2saasfake.PasswordMode) {
+	var fakeServer *db2saasfake.Server
+	var db2saasService *db2saasv1.Db2saasV1
+	createUser := func(id string, iam bool, role string, email string, locked string) {
+		authentication, err := db2saasService.NewCreateUserAuthentication("internal", "Default")
+		Expect(err).To(BeNil())
+		_, _, err = db2saasService.PostDb2SaasUser(db2saasService.NewPostDb2SaasUserOptions(fakeCRN, id, iam, id+"-ibm-id", "User "+id,
+			"dEkMc43@gfAPl!867^dSbu", role, email, locked, authentication))
+		Expect(err).To(BeNil())
+	}
+	BeforeEach(func() {
+		var err error
+		fakeServer = db2saasfake.NewServer()
+		fakeServer.Passwords = passwords
+		db2saasService, err = fakeServer.NewService()
+		Expect(err).To(BeNil())
+
+		createUser("carol", false, "bluuser", "carol@Example.org", "no")
+		createUser("alice", true, "bluadmin", "alice@example.org", "no")
+		createUser("dave", false, "bluuser", "dave@host.org", "yes")
+		createUser("bob", true, "bluuser", "bob@mail.example.org", "no")
+	})
+	AfterEach(func() {
+		fakeServer.Close()
+	})
+
+	ids := func(users []db2saasv1.SuccessGetUserInfoResourcesItem) []string {
+		ids := []string{}
+		for _, user := range users {
+			ids = append(ids, *user.ID)
+		}
+		return ids
+	}
+
+	It(`Invoke QueryUsers successfully`, func() {
+		for _, test := range []struct {
+			filter   *db2saasv1.UserFilter
+			sort     string
+			expected []string
+		}{
+			{nil, db2saasv1.QueryUsersOptions_Sort_ID, []string{"alice", "bob", "carol", "dave"}},
+			{&db2saasv1.UserFilter{}, db2saasv1.QueryUsersOptions_Sort_IDDesc, []string{"dave", "carol", "bob", "alice"}},
+			{&db2saasv1.UserFilter{Roles: []db2saasv1.UserRole{db2saasv1.UserRole_Bluuser}}, "id", []string{"bob", "carol", "dave"}},
+			{&db2saasv1.UserFilter{Locked: core.BoolPtr(true)}, "", []string{"dave"}},
+			{&db2saasv1.UserFilter{Locked: core.BoolPtr(false), Iam: core.BoolPtr(false)}, "", []string{"carol"}},
+			{&db2saasv1.UserFilter{Iam: core.BoolPtr(true)}, "-email", []string{"bob", "alice"}},
+			{&db2saasv1.UserFilter{EmailDomains: []string{"example.org"}}, "email", []string{"alice", "carol"}},
+			{&db2saasv1.UserFilter{EmailDomains: []string{"@host.org", "mail.example.org"}}, "id", []string{"bob", "dave"}},
+			{&db2saasv1.UserFilter{Search: "EXAMPLE", Roles: []db2saasv1.UserRole{db2saasv1.UserRole_Bluuser}}, "name", []string{"bob", "carol"}},
+			{&db2saasv1.UserFilter{Search: "nobody"}, "id", []string{}},
+		} {
+			queryUsersOptionsModel := db2saasService.NewQueryUsersOptions(fakeCRN).SetFilter(test.filter)
+			if test.sort != "" {
+				queryUsersOptionsModel.SetSort(test.sort)
+			}
+			users, response, err := db2saasService.QueryUsers(queryUsersOptionsModel)
+			Expect(err).To(BeNil())
+			Expect(response).ToNot(BeNil())
+			if test.sort == "" {
+				Expect(ids(users)).To(ConsistOf(test.expected))
+			} else {
+				Expect(ids(users)).To(Equal(test.expected), test.sort)
+			}
+		}
+	})
+	It(`Invoke QueryUsers with error`, func() {
+		_, _, err := db2saasService.QueryUsers(nil)
+		Expect(err).ToNot(BeNil())
+		_, _, err = db2saasService.QueryUsers(db2saasService.NewQueryUsersOptions(fakeCRN).SetSort("Email"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("invalid sort 'Email': values are case-sensitive, use 'email'"))
+		_, _, err = db2saasService.QueryUsers(db2saasService.NewQueryUsersOptions(fakeCRN).SetSort("created_at"))
+		Expect(err).ToNot(BeNil())
+		Expect(fakeServer.Calls(db2saasfake.RouteGetUsers)).To(BeZero())
+	})
+	It(`Sort users without a value last`, func() {
+		users := []db2saasv1.SuccessGetUserInfoResourcesItem{
+			{ID: core.StringPtr("a")}, {ID: core.StringPtr("b"), Email: core.StringPtr("B@host.org")}, {ID: core.StringPtr("c"), Email: core.StringPtr("a@host.org")},
+		}
+		Expect(db2saasv1.SortUsers(users, db2saasv1.QueryUsersOptions_Sort_Email)).To(BeNil())
+		Expect(ids(users)).To(Equal([]string{"c", "b", "a"}))
+		Expect(db2saasv1.SortUsers(users, db2saasv1.QueryUsersOptions_Sort_EmailDesc)).To(BeNil())
+		Expect(ids(users)).To(Equal([]string{"b", "c", "a"}))
+	})
+	Describe(`UsersPager`, func() {
+		BeforeEach(func() {
+			for i := 0; i < 246; i++ {
+				createUser(fmt.Sprintf("user-%03d", i), false, "bluuser", fmt.Sprintf("user-%03d@host.org", i), "no")
+			}
+		})
+		It(`Use UsersPager.GetNext successfully`, func() {
+			pager, err := db2saasService.NewUsersPager(db2saasService.NewQueryUsersOptions(fakeCRN).
+				SetFilter(&db2saasv1.UserFilter{EmailDomains: []string{"host.org"}}).
+				SetSort(db2saasv1.QueryUsersOptions_Sort_ID))
+			Expect(err).To(BeNil())
+
+			var pages [][]db2saasv1.SuccessGetUserInfoResourcesItem
+			for pager.HasNext() {
+				page, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				pages = append(pages, page)
+			}
+			Expect(pages).To(HaveLen(3))
+			Expect(pages[0]).To(HaveLen(db2saasv1.DefaultUsersPageLimit))
+			Expect(pages[2]).To(HaveLen(47))
+			Expect(*pages[0][0].ID).To(Equal("dave"))
+			Expect(*pages[2][46].ID).To(Equal("user-245"))
+			Expect(fakeServer.Calls(db2saasfake.RouteGetUsers)).To(Equal(1))
+
+			_, err = pager.GetNext()
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Use UsersPager.GetAll successfully`, func() {
+			instance, err := db2saasService.Instance(fakeCRN)
+			Expect(err).To(BeNil())
+			pager, err := instance.Users().Pager(&db2saasv1.QueryUsersOptions{Limit: core.Int64Ptr(7)})
+			Expect(err).To(BeNil())
+			users, err := pager.GetAllWithContext(context.Background())
+			Expect(err).To(BeNil())
+			Expect(users).To(HaveLen(250))
+
+			users, _, err = instance.Users().Query(&db2saasv1.QueryUsersOptions{Filter: &db2saasv1.UserFilter{Search: "user-24"}})
+			Expect(err).To(BeNil())
+			Expect(users).To(HaveLen(6))
+		})
+		It(`Use UsersPager with no users`, func() {
+			ref, err := db2saasv1.ParseDeploymentRef(fakeCRN)
+			Expect(err).To(BeNil())
+			pager, err := db2saasService.NewUsersPager(db2saasService.NewQueryUsersOptionsForDeployment(ref).SetFilter(&db2saasv1.UserFilter{Search: "nobody"}))
+			Expect(err).To(BeNil())
+			Expect(pager.HasNext()).To(BeTrue())
+			page, err := pager.GetNext()
+			Expect(err).To(BeNil())
+			Expect(page).To(BeEmpty())
+			Expect(pager.HasNext()).To(BeFalse())
+		})
+		It(`Invoke NewUsersPager with error`, func() {
+			_, err := db2saasService.NewUsersPager(db2saasService.NewQueryUsersOptions(fakeCRN).SetLimit(0))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("must be positive"))
+
+			pager, err := db2saasService.NewUsersPager(db2saasService.NewQueryUsersOptions(fakeCRN).SetSort("age"))
+			Expect(err).To(BeNil())
+			_, err = pager.GetAll()
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
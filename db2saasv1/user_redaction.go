@@ -0,0 +1,378 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"reflect"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// RedactedPassword replaces passwords when user models and options are printed or logged.
+const RedactedPassword = "[REDACTED]"
+
+// SetRedactPasswords sets whether the passwords returned by the user operations are removed from their
+// results. Redaction is on by default: the Password field of SuccessUserResponse, SuccessGetUserByID and
+// SuccessGetUserInfoResourcesItem results is nil, and so is the password in DetailedResponse.Result.
+func (db2saas *Db2saasV1) SetRedactPasswords(redact bool) {
+	db2saas.keepPasswords = !redact
+}
+
+// GetRedactPasswords returns true if passwords are removed from the results of the user operations.
+func (db2saas *Db2saasV1) GetRedactPasswords() bool {
+	return !db2saas.keepPasswords
+}
+
+// keepPasswordsKey marks the contexts of requests made by helpers that need the password of a user, such as
+// UpdateDb2SaasUser, which sends it back unchanged.
+type keepPasswordsKey struct{}
+
+// withPasswords returns a context whose user responses are not redacted.
+func withPasswords(ctx context.Context) context.Context {
+	return context.WithValue(ctx, keepPasswordsKey{}, true)
+}
+
+// redactPasswords returns true if the passwords of user responses received with "ctx" are to be removed.
+func (db2saas *Db2saasV1) redactPasswords(ctx context.Context) bool {
+	return !db2saas.keepPasswords && ctx.Value(keepPasswordsKey{}) == nil
+}
+
+// RedactPassword removes the password of the user.
+func (user *SuccessUserResponse) RedactPassword() {
+	user.Password = nil
+}
+
+// RedactPassword removes the password of the user.
+func (user *SuccessGetUserByID) RedactPassword() {
+	user.Password = nil
+}
+
+// RedactPassword removes the password of the user.
+func (user *SuccessGetUserInfoResourcesItem) RedactPassword() {
+	user.Password = nil
+}
+
+// RedactPassword removes the passwords of the users.
+func (users *SuccessGetUserInfo) RedactPassword() {
+	for i := range users.Resources {
+		users.Resources[i].RedactPassword()
+	}
+}
+
+// redactedPtr returns RedactedPassword if "password" is set.
+func redactedPtr(password *string) *string {
+	if password == nil {
+		return nil
+	}
+	return core.StringPtr(RedactedPassword)
+}
+
+// redactedString returns "redacted", a copy of a model whose secrets are masked, in the form printed by
+// the %+v verb, without the properties that are not set and with pointers dereferenced.
+func redactedString(redacted interface{}) string {
+	var b strings.Builder
+	writeRedacted(&b, reflect.ValueOf(redacted))
+	return b.String()
+}
+
+func writeRedacted(b *strings.Builder, value reflect.Value) {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			b.WriteString("<nil>")
+			return
+		}
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.Struct:
+		b.WriteByte('{')
+		first := true
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if !field.IsExported() || isUnset(value.Field(i)) {
+				continue
+			}
+			if !first {
+				b.WriteByte(' ')
+			}
+			first = false
+			b.WriteString(field.Name)
+			b.WriteByte(':')
+			writeRedacted(b, value.Field(i))
+		}
+		b.WriteByte('}')
+	case reflect.Slice, reflect.Array:
+		b.WriteByte('[')
+		for i := 0; i < value.Len(); i++ {
+			if i > 0 {
+				b.WriteByte(' ')
+			}
+			writeRedacted(b, value.Index(i))
+		}
+		b.WriteByte(']')
+	default:
+		fmt.Fprintf(b, "%v", value.Interface())
+	}
+}
+
+// isUnset returns true if "value" is a nil pointer, map, slice or interface.
+func isUnset(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface:
+		return value.IsNil()
+	}
+	return false
+}
+
+// formatRedacted formats "redacted", a copy of a model whose secrets are masked. The %v and %s verbs print
+// redactedString and %#v its Go syntax.
+func formatRedacted(f fmt.State, verb rune, redacted interface{}) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprintf(f, "%#v", redacted)
+	case verb == 'v' || verb == 's':
+		io.WriteString(f, redactedString(redacted))
+	case verb == 'q':
+		fmt.Fprintf(f, "%q", redactedString(redacted))
+	default:
+		fmt.Fprintf(f, "%%!%c(%s)", verb, redactedString(redacted))
+	}
+}
+
+// redactedLogValue returns a group with the properties of "redacted", a copy of a model whose secrets are
+// masked, that are set.
+func redactedLogValue(redacted interface{}) slog.Value {
+	value := reflect.ValueOf(redacted)
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return slog.AnyValue(nil)
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return slog.AnyValue(value.Interface())
+	}
+	var attrs []slog.Attr
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() || isUnset(value.Field(i)) {
+			continue
+		}
+		fieldValue := value.Field(i)
+		for fieldValue.Kind() == reflect.Pointer {
+			fieldValue = fieldValue.Elem()
+		}
+		if fieldValue.Kind() == reflect.Struct {
+			attrs = append(attrs, slog.Attr{Key: field.Name, Value: redactedLogValue(fieldValue.Interface())})
+		} else {
+			attrs = append(attrs, slog.Any(field.Name, fieldValue.Interface()))
+		}
+	}
+	return slog.GroupValue(attrs...)
+}
+
+type redactedSuccessUserResponse SuccessUserResponse
+
+func (user SuccessUserResponse) redacted() redactedSuccessUserResponse {
+	user.Password = redactedPtr(user.Password)
+	return redactedSuccessUserResponse(user)
+}
+
+// String returns the properties of the user that are set, with its password redacted.
+func (user SuccessUserResponse) String() string {
+	return redactedString(user.redacted())
+}
+
+// Format implements fmt.Formatter so that the password of the user is never printed.
+func (user SuccessUserResponse) Format(f fmt.State, verb rune) {
+	formatRedacted(f, verb, user.redacted())
+}
+
+// LogValue implements slog.LogValuer so that the password of the user is never logged.
+func (user SuccessUserResponse) LogValue() slog.Value {
+	return redactedLogValue(user.redacted())
+}
+
+type redactedSuccessGetUserByID SuccessGetUserByID
+
+func (user SuccessGetUserByID) redacted() redactedSuccessGetUserByID {
+	user.Password = redactedPtr(user.Password)
+	return redactedSuccessGetUserByID(user)
+}
+
+// String returns the properties of the user that are set, with its password redacted.
+func (user SuccessGetUserByID) String() string {
+	return redactedString(user.redacted())
+}
+
+// Format implements fmt.Formatter so that the password of the user is never printed.
+func (user SuccessGetUserByID) Format(f fmt.State, verb rune) {
+	formatRedacted(f, verb, user.redacted())
+}
+
+// LogValue implements slog.LogValuer so that the password of the user is never logged.
+func (user SuccessGetUserByID) LogValue() slog.Value {
+	return redactedLogValue(user.redacted())
+}
+
+type redactedSuccessGetUserInfoResourcesItem SuccessGetUserInfoResourcesItem
+
+func (user SuccessGetUserInfoResourcesItem) redacted() redactedSuccessGetUserInfoResourcesItem {
+	user.Password = redactedPtr(user.Password)
+	return redactedSuccessGetUserInfoResourcesItem(user)
+}
+
+// String returns the properties of the user that are set, with its password redacted.
+func (user SuccessGetUserInfoResourcesItem) String() string {
+	return redactedString(user.redacted())
+}
+
+// Format implements fmt.Formatter so that the password of the user is never printed.
+func (user SuccessGetUserInfoResourcesItem) Format(f fmt.State, verb rune) {
+	formatRedacted(f, verb, user.redacted())
+}
+
+// LogValue implements slog.LogValuer so that the password of the user is never logged.
+func (user SuccessGetUserInfoResourcesItem) LogValue() slog.Value {
+	return redactedLogValue(user.redacted())
+}
+
+type redactedSuccessGetUserInfo struct {
+	Count     *int64
+	Resources []redactedSuccessGetUserInfoResourcesItem
+}
+
+func (users SuccessGetUserInfo) redacted() redactedSuccessGetUserInfo {
+	redacted := redactedSuccessGetUserInfo{Count: users.Count}
+	if users.Resources != nil {
+		redacted.Resources = make([]redactedSuccessGetUserInfoResourcesItem, len(users.Resources))
+		for i := range users.Resources {
+			redacted.Resources[i] = users.Resources[i].redacted()
+		}
+	}
+	return redacted
+}
+
+// String returns the properties of the users that are set, with their passwords redacted.
+func (users SuccessGetUserInfo) String() string {
+	return redactedString(users.redacted())
+}
+
+// Format implements fmt.Formatter so that the passwords of the users are never printed.
+func (users SuccessGetUserInfo) Format(f fmt.State, verb rune) {
+	formatRedacted(f, verb, users.redacted())
+}
+
+// LogValue implements slog.LogValuer so that the passwords of the users are never logged.
+func (users SuccessGetUserInfo) LogValue() slog.Value {
+	return redactedLogValue(users.redacted())
+}
+
+type redactedPostDb2SaasUserOptions PostDb2SaasUserOptions
+
+func (options PostDb2SaasUserOptions) redacted() redactedPostDb2SaasUserOptions {
+	options.Password = redactedPtr(options.Password)
+	return redactedPostDb2SaasUserOptions(options)
+}
+
+// String returns the options that are set, with the password redacted.
+func (options PostDb2SaasUserOptions) String() string {
+	return redactedString(options.redacted())
+}
+
+// Format implements fmt.Formatter so that the password is never printed.
+func (options PostDb2SaasUserOptions) Format(f fmt.State, verb rune) {
+	formatRedacted(f, verb, options.redacted())
+}
+
+// LogValue implements slog.LogValuer so that the password is never logged.
+func (options PostDb2SaasUserOptions) LogValue() slog.Value {
+	return redactedLogValue(options.redacted())
+}
+
+type redactedPutDb2SaasUserOptions PutDb2SaasUserOptions
+
+func (options PutDb2SaasUserOptions) redacted() redactedPutDb2SaasUserOptions {
+	options.NewPassword = redactedPtr(options.NewPassword)
+	return redactedPutDb2SaasUserOptions(options)
+}
+
+// String returns the options that are set, with the password redacted.
+func (options PutDb2SaasUserOptions) String() string {
+	return redactedString(options.redacted())
+}
+
+// Format implements fmt.Formatter so that the password is never printed.
+func (options PutDb2SaasUserOptions) Format(f fmt.State, verb rune) {
+	formatRedacted(f, verb, options.redacted())
+}
+
+// LogValue implements slog.LogValuer so that the password is never logged.
+func (options PutDb2SaasUserOptions) LogValue() slog.Value {
+	return redactedLogValue(options.redacted())
+}
+
+type redactedUpdateDb2SaasUserOptions UpdateDb2SaasUserOptions
+
+func (options UpdateDb2SaasUserOptions) redacted() redactedUpdateDb2SaasUserOptions {
+	options.NewPassword = redactedPtr(options.NewPassword)
+	return redactedUpdateDb2SaasUserOptions(options)
+}
+
+// String returns the options that are set, with the password redacted.
+func (options UpdateDb2SaasUserOptions) String() string {
+	return redactedString(options.redacted())
+}
+
+// Format implements fmt.Formatter so that the password is never printed.
+func (options UpdateDb2SaasUserOptions) Format(f fmt.State, verb rune) {
+	formatRedacted(f, verb, options.redacted())
+}
+
+// LogValue implements slog.LogValuer so that the password is never logged.
+func (options UpdateDb2SaasUserOptions) LogValue() slog.Value {
+	return redactedLogValue(options.redacted())
+}
+
+type redactedDesiredUser DesiredUser
+
+func (user DesiredUser) redacted() redactedDesiredUser {
+	if user.Password != "" {
+		user.Password = RedactedPassword
+	}
+	return redactedDesiredUser(user)
+}
+
+// String returns the properties of the user that are set, with its password redacted.
+func (user DesiredUser) String() string {
+	return redactedString(user.redacted())
+}
+
+// Format implements fmt.Formatter so that the password of the user is never printed.
+func (user DesiredUser) Format(f fmt.State, verb rune) {
+	formatRedacted(f, verb, user.redacted())
+}
+
+// LogValue implements slog.LogValuer so that the password of the user is never logged.
+func (user DesiredUser) LogValue() slog.Value {
+	return redactedLogValue(user.redacted())
+}
@@ -0,0 +1,142 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1_test
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+
+	"github.com/IBM/cloud-db2-go-sdk/db2saasfake"
+	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = describePasswordModes(`Password redaction`, func(passwords db2saasfake.PasswordMode) {
+	const password = "dEkMc43@gfAPl!867^dSbu"
+	var fakeServer *db2saasfake.Server
+	var db2saasService *db2saasv1.Db2saasV1
+	var postDb2SaasUserOptionsModel *db2saasv1.PostDb2SaasUserOptions
+	BeforeEach(func() {
+		var err error
+		fakeServer = db2saasfake.NewServer()
+		fakeServer.Passwords = passwords
+		db2saasService, err = fakeServer.NewService()
+		Expect(err).To(BeNil())
+
+		authentication, err := db2saasService.NewCreateUserAuthentication("internal", "Default")
+		Expect(err).To(BeNil())
+		postDb2SaasUserOptionsModel = db2saasService.NewPostDb2SaasUserOptions(fakeCRN, "test-user", false, "test-ibm-id",
+			"Test User", password, "bluuser", "test@host.org", "no", authentication)
+	})
+	AfterEach(func() {
+		fakeServer.Close()
+	})
+
+	It(`Invoke user operations successfully: Passwords are redacted by default`, func() {
+		Expect(db2saasService.GetRedactPasswords()).To(BeTrue())
+
+		successUserResponse, response, err := db2saasService.PostDb2SaasUser(postDb2SaasUserOptionsModel)
+		Expect(err).To(BeNil())
+		Expect(successUserResponse.Password).To(BeNil())
+		Expect(*successUserResponse.ID).To(Equal("test-user"))
+		Expect(response.Result).To(Equal(successUserResponse))
+		Expect(response.String()).ToNot(ContainSubstring(password))
+
+		successGetUserByID, response, err := db2saasService.GetbyidDb2SaasUser(db2saasService.NewGetbyidDb2SaasUserOptions(fakeCRN, "test-user"))
+		Expect(err).To(BeNil())
+		Expect(successGetUserByID.Password).To(BeNil())
+		Expect(response.String()).ToNot(ContainSubstring(password))
+
+		successGetUserInfo, _, err := db2saasService.GetDb2SaasUser(db2saasService.NewGetDb2SaasUserOptions(fakeCRN))
+		Expect(err).To(BeNil())
+		Expect(successGetUserInfo.Resources).To(HaveLen(1))
+		Expect(successGetUserInfo.Resources[0].Password).To(BeNil())
+
+		updateDb2SaasUserOptionsModel := db2saasService.NewUpdateDb2SaasUserOptions(fakeCRN, "test-user").SetNewEmail("new@host.org")
+		if passwords != db2saasfake.PasswordsEchoed {
+			updateDb2SaasUserOptionsModel.SetNewPassword(password)
+		}
+		successUserResponse, _, err = db2saasService.UpdateDb2SaasUser(updateDb2SaasUserOptionsModel)
+		Expect(err).To(BeNil())
+		Expect(successUserResponse.Password).To(BeNil())
+	})
+	It(`Invoke user operations successfully: Redaction disabled`, func() {
+		db2saasService.SetRedactPasswords(false)
+		Expect(db2saasService.GetRedactPasswords()).To(BeFalse())
+		Expect(db2saasService.Clone().GetRedactPasswords()).To(BeFalse())
+		returned := map[db2saasfake.PasswordMode]*string{
+			db2saasfake.PasswordsEchoed:  core.StringPtr(password),
+			db2saasfake.PasswordsOmitted: nil,
+			db2saasfake.PasswordsMasked:  core.StringPtr(db2saasfake.MaskedPassword),
+		}[passwords]
+
+		successUserResponse, _, err := db2saasService.PostDb2SaasUser(postDb2SaasUserOptionsModel)
+		Expect(err).To(BeNil())
+		Expect(successUserResponse.Password).To(Equal(returned))
+
+		successGetUserInfo, _, err := db2saasService.GetDb2SaasUser(db2saasService.NewGetDb2SaasUserOptions(fakeCRN))
+		Expect(err).To(BeNil())
+		Expect(successGetUserInfo.Resources[0].Password).To(Equal(returned))
+
+		successGetUserInfo.RedactPassword()
+		Expect(successGetUserInfo.Resources[0].Password).To(BeNil())
+	})
+	It(`Print user models without their passwords`, func() {
+		successGetUserByID := &db2saasv1.SuccessGetUserByID{ID: core.StringPtr("test-user"), Password: core.StringPtr(password)}
+		successUserResponse := db2saasv1.SuccessUserResponse{ID: core.StringPtr("test-user"), Password: core.StringPtr(password)}
+		successGetUserInfo := db2saasv1.SuccessGetUserInfo{
+			Count:     core.Int64Ptr(1),
+			Resources: []db2saasv1.SuccessGetUserInfoResourcesItem{{ID: core.StringPtr("test-user"), Password: core.StringPtr(password)}},
+		}
+		for _, model := range []interface{}{
+			successGetUserByID,
+			successUserResponse,
+			successGetUserInfo,
+			successGetUserInfo.Resources[0],
+			postDb2SaasUserOptionsModel,
+			db2saasv1.PutDb2SaasUserOptions{ID: core.StringPtr("test-user"), NewPassword: core.StringPtr(password)},
+			db2saasService.NewUpdateDb2SaasUserOptions(fakeCRN, "test-user").SetNewPassword(password),
+			db2saasv1.DesiredUser{ID: "test-user", Password: password},
+		} {
+			for _, format := range []string{"%v", "%+v", "%#v", "%s", "%q"} {
+				printed := fmt.Sprintf(format, model)
+				Expect(printed).ToNot(ContainSubstring(password), format)
+				if format != "%#v" {
+					Expect(printed).To(ContainSubstring("test-user"), format)
+				}
+			}
+		}
+
+		Expect(successUserResponse.String()).To(Equal(`{Password:[REDACTED] ID:test-user}`))
+		Expect(fmt.Sprintf("%#v", successUserResponse)).To(ContainSubstring(`Password:(*string)`))
+		Expect(*successUserResponse.Password).To(Equal(password))
+		Expect(fmt.Sprint(successGetUserInfo)).To(Equal(`{Count:1 Resources:[{Password:[REDACTED] ID:test-user}]}`))
+	})
+	It(`Log user models without their passwords`, func() {
+		var buffer bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buffer, nil))
+		logger.Info("user created",
+			"user", db2saasv1.SuccessUserResponse{ID: core.StringPtr("test-user"), Password: core.StringPtr(password)},
+			"options", postDb2SaasUserOptionsModel)
+		Expect(buffer.String()).ToNot(ContainSubstring(password))
+		Expect(buffer.String()).To(ContainSubstring(`"user":{"Password":"[REDACTED]","ID":"test-user"}`))
+		Expect(buffer.String()).To(ContainSubstring(`"Password":"[REDACTED]","Role":"bluuser"`))
+	})
+})
@@ -0,0 +1,564 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	common "github.com/IBM/cloud-db2-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultProtectedUsers are the ids of the users SyncUsers never deletes unless SyncUsersOptions.ProtectedUsers
+// is set: the administrator account every deployment is created with.
+var DefaultProtectedUsers = []string{"bluadmin"}
+
+// Actions of a UserSyncAction.
+const (
+	UserSyncAction_Action_Create = "create"
+	UserSyncAction_Action_Update = "update"
+	UserSyncAction_Action_Lock   = "lock"
+	UserSyncAction_Action_Unlock = "unlock"
+	UserSyncAction_Action_Delete = "delete"
+)
+
+// Statuses of a UserSyncAction.
+const (
+	UserSyncAction_Status_Planned = "planned"
+	UserSyncAction_Status_Applied = "applied"
+	UserSyncAction_Status_Failed  = "failed"
+	UserSyncAction_Status_Skipped = "skipped"
+)
+
+// DesiredUser : The desired state of a database user.
+//
+// Only the properties that are set are managed: a user whose Email is empty keeps its current email, for
+// instance. Name is only used to create the user. Password is required to create the user; it is also required
+// to update the user when the service does not return passwords, since an update sends the password back.
+type DesiredUser struct {
+	// The id of the user.
+	ID string `json:"id" yaml:"id"`
+
+	// The role of the user. New users default to UserRole_Bluuser.
+	Role UserRole `json:"role,omitempty" yaml:"role,omitempty"`
+
+	// The email of the user.
+	Email string `json:"email,omitempty" yaml:"email,omitempty"`
+
+	// Whether the user is locked. New users default to unlocked.
+	Locked *bool `json:"locked,omitempty" yaml:"locked,omitempty"`
+
+	// Whether IAM is enabled for the user. New users default to false.
+	Iam *bool `json:"iam,omitempty" yaml:"iam,omitempty"`
+
+	// The IBM ID of the user.
+	Ibmid string `json:"ibmid,omitempty" yaml:"ibmid,omitempty"`
+
+	// The name of a new user. Defaults to the id.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// The password of the user. Required to create the user, and to update it when the service does not
+	// return its current password.
+	Password string `json:"-" yaml:"password,omitempty"`
+}
+
+// UserSyncAction : A change SyncUsers makes, or would make in dry-run mode, to converge on the desired users.
+type UserSyncAction struct {
+	// The kind of change.
+	Action string `json:"action"`
+
+	// The id of the user.
+	ID string `json:"id"`
+
+	// The properties that change, named as in the request body, e.g. "email". Empty for creations and deletions.
+	Fields []string `json:"fields,omitempty"`
+
+	// One of the UserSyncAction_Status_* constants.
+	Status string `json:"status"`
+
+	// Why the action failed, if Status is "failed".
+	Error error `json:"-"`
+
+	desired *DesiredUser
+
+	// True if the update sends the desired password because the service did not return the current one.
+	sendPassword bool
+}
+
+// UserSyncResult : The outcome of SyncUsers.
+type UserSyncResult struct {
+	// True if the actions were only planned.
+	DryRun bool `json:"dry_run"`
+
+	// The actions: creations and updates in desired order, then deletions in current order.
+	Actions []UserSyncAction `json:"actions"`
+
+	// The users that are not desired but were kept because they are protected.
+	Protected []string `json:"protected"`
+}
+
+// HasChanges returns true if there are actions.
+func (result *UserSyncResult) HasChanges() bool {
+	return len(result.Actions) > 0
+}
+
+// WriteReport writes a human-readable summary of the result to "w".
+func (result *UserSyncResult) WriteReport(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	mode := ""
+	if result.DryRun {
+		mode = " (dry run)"
+	}
+	fmt.Fprintf(tw, "User sync%s: %d actions, %d protected\n", mode, len(result.Actions), len(result.Protected))
+	if !result.HasChanges() {
+		fmt.Fprintf(tw, "No changes. The users are up to date.\n")
+	} else {
+		fmt.Fprintf(tw, "\nACTION\tUSER\tSTATUS\tDETAILS\n")
+		for _, action := range result.Actions {
+			details := strings.Join(action.Fields, ", ")
+			if action.Error != nil {
+				details = action.Error.Error()
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", action.Action, action.ID, action.Status, details)
+		}
+	}
+	for _, id := range result.Protected {
+		fmt.Fprintf(tw, "Kept protected user '%s'\n", id)
+	}
+	return tw.Flush()
+}
+
+// ValidateDesiredUsers checks that every desired user has an id, that no id repeats, and that roles are valid.
+func ValidateDesiredUsers(users []DesiredUser) error {
+	var errs []error
+	ids := make(map[string]int, len(users))
+	for i := range users {
+		switch first, ok := ids[users[i].ID]; {
+		case users[i].ID == "":
+			errs = append(errs, fmt.Errorf("users[%d]: id is empty", i))
+		case ok:
+			errs = append(errs, fmt.Errorf("users[%d]: user '%s' is also users[%d]", i, users[i].ID, first))
+		default:
+			ids[users[i].ID] = i
+		}
+		if users[i].Role != "" {
+			if err := users[i].Role.Validate(); err != nil {
+				errs = append(errs, fmt.Errorf("users[%d]: %w", i, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ReadDesiredUsers reads desired users from YAML (or JSON): either a list of users or a mapping whose
+// "users" key holds the list. Unknown properties are rejected, and the users are checked with
+// ValidateDesiredUsers.
+func ReadDesiredUsers(r io.Reader) (users []DesiredUser, err error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "desired-users-read-error", common.GetComponentInfo())
+		return
+	}
+	var document yaml.Node
+	if err = yaml.Unmarshal(data, &document); err != nil {
+		err = core.SDKErrorf(err, "", "desired-users-read-error", common.GetComponentInfo())
+		return
+	}
+	users = []DesiredUser{}
+	if len(document.Content) > 0 {
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if document.Content[0].Kind == yaml.MappingNode {
+			var file struct {
+				Users []DesiredUser `yaml:"users"`
+			}
+			err = decoder.Decode(&file)
+			if file.Users != nil {
+				users = file.Users
+			}
+		} else {
+			err = decoder.Decode(&users)
+		}
+		if err != nil {
+			err = core.SDKErrorf(err, "", "desired-users-read-error", common.GetComponentInfo())
+			return
+		}
+	}
+	if err = ValidateDesiredUsers(users); err != nil {
+		err = core.SDKErrorf(err, "", "desired-users-validation-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// ReadDesiredUsersFile reads desired users from a YAML or JSON file. See ReadDesiredUsers.
+func ReadDesiredUsersFile(path string) (users []DesiredUser, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "desired-users-read-error", common.GetComponentInfo())
+		return
+	}
+	defer f.Close()
+	return ReadDesiredUsers(f)
+}
+
+// SyncUsersOptions : The SyncUsers options.
+type SyncUsersOptions struct {
+	// CRN deployment id.
+	XDeploymentID *string `json:"x-deployment-id" validate:"required"`
+
+	// The desired users. An empty, non-nil list deletes every unprotected user.
+	Users []DesiredUser `json:"users" validate:"required"`
+
+	// If true, the actions are planned but not applied.
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// The ids of the users that are never deleted, in addition to every user with the bluadmin role.
+	// Defaults to DefaultProtectedUsers.
+	ProtectedUsers []string `json:"protected_users,omitempty"`
+
+	// The authentication of new users. Defaults to the "internal" method with the "Default" policy.
+	Authentication *CreateUserAuthentication `json:"authentication,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewSyncUsersOptions : Instantiate SyncUsersOptions
+func (*Db2saasV1) NewSyncUsersOptions(xDeploymentID string, users []DesiredUser) *SyncUsersOptions {
+	return &SyncUsersOptions{
+		XDeploymentID: core.StringPtr(xDeploymentID),
+		Users:         users,
+	}
+}
+
+// NewSyncUsersOptionsForDeployment : Instantiate SyncUsersOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewSyncUsersOptionsForDeployment(ref *DeploymentRef, users []DesiredUser) *SyncUsersOptions {
+	return db2saas.NewSyncUsersOptions(ref.CRN(), users)
+}
+
+// SetXDeploymentID : Allow user to set XDeploymentID
+func (_options *SyncUsersOptions) SetXDeploymentID(xDeploymentID string) *SyncUsersOptions {
+	_options.XDeploymentID = core.StringPtr(xDeploymentID)
+	return _options
+}
+
+// SetUsers : Allow user to set Users
+func (_options *SyncUsersOptions) SetUsers(users []DesiredUser) *SyncUsersOptions {
+	_options.Users = users
+	return _options
+}
+
+// SetDryRun : Allow user to set DryRun
+func (_options *SyncUsersOptions) SetDryRun(dryRun bool) *SyncUsersOptions {
+	_options.DryRun = core.BoolPtr(dryRun)
+	return _options
+}
+
+// SetProtectedUsers : Allow user to set ProtectedUsers
+func (_options *SyncUsersOptions) SetProtectedUsers(protectedUsers []string) *SyncUsersOptions {
+	_options.ProtectedUsers = protectedUsers
+	return _options
+}
+
+// SetAuthentication : Allow user to set Authentication
+func (_options *SyncUsersOptions) SetAuthentication(authentication *CreateUserAuthentication) *SyncUsersOptions {
+	_options.Authentication = authentication
+	return _options
+}
+
+// SetDeployment : Allow user to set XDeploymentID from a DeploymentRef
+func (_options *SyncUsersOptions) SetDeployment(ref *DeploymentRef) *SyncUsersOptions {
+	_options.XDeploymentID = core.StringPtr(ref.CRN())
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *SyncUsersOptions) SetHeaders(param map[string]string) *SyncUsersOptions {
+	options.Headers = param
+	return options
+}
+
+// SyncUsers : Converge the users of a deployment on the desired users
+func (db2saas *Db2saasV1) SyncUsers(syncUsersOptions *SyncUsersOptions) (result *UserSyncResult, err error) {
+	result, err = db2saas.SyncUsersWithContext(context.Background(), syncUsersOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SyncUsersWithContext is an alternate form of the SyncUsers method which supports a Context parameter.
+// It compares the desired users with the users listed by GetDb2SaasUser and, unless DryRun is set, applies
+// the resulting actions in order with PostDb2SaasUser, UpdateDb2SaasUser and DeleteDb2SaasUser. Users that
+// are not desired are deleted, except protected users and users with the bluadmin role. Desired users that
+// do not exist yet need a password; if one has none, nothing is applied. The same holds for the users to
+// update when the service does not return their password, since UpdateDb2SaasUser sends it back: the desired
+// password is sent instead, and if there is none the sync fails with a UserPasswordUnavailableError before
+// any action is applied. The first failed action stops the sync; the actions after it are reported as skipped.
+func (db2saas *Db2saasV1) SyncUsersWithContext(ctx context.Context, syncUsersOptions *SyncUsersOptions) (result *UserSyncResult, err error) {
+	err = core.ValidateNotNil(syncUsersOptions, "syncUsersOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(syncUsersOptions, "syncUsersOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	err = ValidateDesiredUsers(syncUsersOptions.Users)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "desired-users-validation-error", common.GetComponentInfo())
+		return
+	}
+	var passwordErrs []error
+	for i, user := range syncUsersOptions.Users {
+		if user.Password == "" {
+			continue
+		}
+		if passwordErr := db2saas.validatePassword(&user.Password, &user.ID); passwordErr != nil {
+			passwordErrs = append(passwordErrs, fmt.Errorf("users[%d]: %w", i, passwordErr))
+		}
+	}
+	if err = errors.Join(passwordErrs...); err != nil {
+		err = core.SDKErrorf(err, "", "password-policy-error", common.GetComponentInfo())
+		return
+	}
+
+	// The passwords tell which updates need the desired password, so they are read even when the client
+	// redacts passwords.
+	current, _, err := db2saas.GetDb2SaasUserWithContext(withPasswords(ctx), &GetDb2SaasUserOptions{
+		XDeploymentID: syncUsersOptions.XDeploymentID,
+		Headers:       syncUsersOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-users-error")
+		return
+	}
+	existing := make(map[string]bool, len(current.Resources))
+	for i := range current.Resources {
+		existing[core.StringNilMapper(current.Resources[i].ID)] = true
+	}
+	var missingErrs []error
+	for i, user := range syncUsersOptions.Users {
+		if user.Password == "" && !existing[user.ID] {
+			missingErrs = append(missingErrs, fmt.Errorf("users[%d]: a password is required to create user '%s'", i, user.ID))
+		}
+	}
+	if err = errors.Join(missingErrs...); err != nil {
+		err = core.SDKErrorf(err, "", "missing-password-error", common.GetComponentInfo())
+		return
+	}
+
+	protectedUsers := syncUsersOptions.ProtectedUsers
+	if protectedUsers == nil {
+		protectedUsers = DefaultProtectedUsers
+	}
+	result = planUserSync(current.Resources, syncUsersOptions.Users, protectedUsers)
+	err = checkUserSyncPasswords(current.Resources, result.Actions)
+	if err != nil {
+		result = nil
+		err = core.SDKErrorf(err, "", "user-password-unavailable", common.GetComponentInfo())
+		return
+	}
+	result.DryRun = syncUsersOptions.DryRun != nil && *syncUsersOptions.DryRun
+	if result.DryRun {
+		return
+	}
+
+	for i := range result.Actions {
+		action := &result.Actions[i]
+		if err != nil {
+			action.Status = UserSyncAction_Status_Skipped
+			continue
+		}
+		action.Error = db2saas.applyUserSyncAction(ctx, syncUsersOptions, action)
+		if action.Error != nil {
+			action.Status = UserSyncAction_Status_Failed
+			err = fmt.Errorf("%s user '%s': %w", action.Action, action.ID, action.Error)
+			err = core.SDKErrorf(err, "", "sync-users-error", common.GetComponentInfo())
+			continue
+		}
+		action.Status = UserSyncAction_Status_Applied
+	}
+	return
+}
+
+// planUserSync returns the actions that turn the "current" users into the "desired" ones.
+func planUserSync(current []SuccessGetUserInfoResourcesItem, desired []DesiredUser, protectedUsers []string) *UserSyncResult {
+	result := &UserSyncResult{Actions: []UserSyncAction{}, Protected: []string{}}
+	currentByID := make(map[string]*SuccessGetUserInfoResourcesItem, len(current))
+	for i := range current {
+		currentByID[core.StringNilMapper(current[i].ID)] = &current[i]
+	}
+	desiredIDs := make(map[string]bool, len(desired))
+	for i := range desired {
+		user := &desired[i]
+		desiredIDs[user.ID] = true
+		existing, ok := currentByID[user.ID]
+		if !ok {
+			result.Actions = append(result.Actions, UserSyncAction{
+				Action:  UserSyncAction_Action_Create,
+				ID:      user.ID,
+				Status:  UserSyncAction_Status_Planned,
+				desired: user,
+			})
+			continue
+		}
+		var fields []string
+		if user.Role != "" && user.Role != existing.UserRole() {
+			fields = append(fields, "role")
+		}
+		if user.Email != "" && user.Email != core.StringNilMapper(existing.Email) {
+			fields = append(fields, "email")
+		}
+		if user.Iam != nil && *user.Iam != (existing.Iam != nil && *existing.Iam) {
+			fields = append(fields, "iam")
+		}
+		if user.Ibmid != "" && user.Ibmid != core.StringNilMapper(existing.Ibmid) {
+			fields = append(fields, "ibmid")
+		}
+		lockChanged := user.Locked != nil && *user.Locked != existing.IsLocked()
+		if lockChanged {
+			fields = append(fields, "locked")
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		action := UserSyncAction{
+			Action:  UserSyncAction_Action_Update,
+			ID:      user.ID,
+			Fields:  fields,
+			Status:  UserSyncAction_Status_Planned,
+			desired: user,
+		}
+		if lockChanged && len(fields) == 1 {
+			action.Action = UserSyncAction_Action_Unlock
+			if *user.Locked {
+				action.Action = UserSyncAction_Action_Lock
+			}
+		}
+		result.Actions = append(result.Actions, action)
+	}
+	for i := range current {
+		id := core.StringNilMapper(current[i].ID)
+		if desiredIDs[id] {
+			continue
+		}
+		if containsString(protectedUsers, id) || current[i].UserRole() == UserRole_Bluadmin {
+			result.Protected = append(result.Protected, id)
+			continue
+		}
+		result.Actions = append(result.Actions, UserSyncAction{
+			Action: UserSyncAction_Action_Delete,
+			ID:     id,
+			Status: UserSyncAction_Status_Planned,
+		})
+	}
+	return result
+}
+
+// checkUserSyncPasswords marks the updates of users whose password the service did not return, so that they
+// send the desired password, and returns an error for each of them that has no desired password.
+func checkUserSyncPasswords(current []SuccessGetUserInfoResourcesItem, actions []UserSyncAction) error {
+	returned := make(map[string]bool, len(current))
+	for i := range current {
+		returned[core.StringNilMapper(current[i].ID)] = isReturnedPassword(current[i].Password)
+	}
+	var errs []error
+	for i := range actions {
+		action := &actions[i]
+		if action.Action == UserSyncAction_Action_Create || action.Action == UserSyncAction_Action_Delete || returned[action.ID] {
+			continue
+		}
+		if action.desired.Password == "" {
+			errs = append(errs, fmt.Errorf("%s user '%s': %w", action.Action, action.ID, &UserPasswordUnavailableError{ID: action.ID}))
+			continue
+		}
+		action.sendPassword = true
+	}
+	return errors.Join(errs...)
+}
+
+// applyUserSyncAction performs one action of SyncUsers.
+func (db2saas *Db2saasV1) applyUserSyncAction(ctx context.Context, syncUsersOptions *SyncUsersOptions, action *UserSyncAction) (err error) {
+	user := action.desired
+	switch action.Action {
+	case UserSyncAction_Action_Create:
+		authentication := syncUsersOptions.Authentication
+		if authentication == nil {
+			authentication = &CreateUserAuthentication{Method: core.StringPtr("internal"), PolicyID: core.StringPtr("Default")}
+		}
+		options := &PostDb2SaasUserOptions{
+			XDeploymentID:  syncUsersOptions.XDeploymentID,
+			ID:             core.StringPtr(user.ID),
+			Iam:            core.BoolPtr(user.Iam != nil && *user.Iam),
+			Ibmid:          core.StringPtr(user.Ibmid),
+			Name:           core.StringPtr(user.Name),
+			Password:       core.StringPtr(user.Password),
+			Role:           core.StringPtr(string(UserRole_Bluuser)),
+			Email:          core.StringPtr(user.Email),
+			Locked:         core.StringPtr(string(LockStateFromBool(user.Locked != nil && *user.Locked))),
+			Authentication: authentication,
+			Headers:        syncUsersOptions.Headers,
+		}
+		if user.Name == "" {
+			options.Name = options.ID
+		}
+		if user.Role != "" {
+			options.Role = core.StringPtr(string(user.Role))
+		}
+		_, _, err = db2saas.PostDb2SaasUserWithContext(ctx, options)
+	case UserSyncAction_Action_Delete:
+		_, err = db2saas.DeleteDb2SaasUserWithContext(ctx, &DeleteDb2SaasUserOptions{
+			XDeploymentID: syncUsersOptions.XDeploymentID,
+			ID:            core.StringPtr(action.ID),
+			Headers:       syncUsersOptions.Headers,
+		})
+	default:
+		options := &UpdateDb2SaasUserOptions{
+			XDeploymentID: syncUsersOptions.XDeploymentID,
+			ID:            core.StringPtr(action.ID),
+			Headers:       syncUsersOptions.Headers,
+		}
+		for _, field := range action.Fields {
+			switch field {
+			case "role":
+				options.SetNewUserRole(user.Role)
+			case "email":
+				options.SetNewEmail(user.Email)
+			case "iam":
+				options.SetNewIam(*user.Iam)
+			case "ibmid":
+				options.SetNewIbmid(user.Ibmid)
+			case "locked":
+				options.SetNewLockedBool(*user.Locked)
+			}
+		}
+		if action.sendPassword {
+			options.SetNewPassword(user.Password)
+		}
+		_, _, err = db2saas.UpdateDb2SaasUserWithContext(ctx, options)
+	}
+	return
+}
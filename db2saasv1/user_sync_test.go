@@ -0,0 +1,245 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/cloud-db2-go-sdk/db2saasfake"
+	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = describePasswordModes(`SyncUsers`, func(passwords db2saasfake.PasswordMode) {
+	var fakeServer *db2saasfake.Server
+	var db2saasService *db2saasv1.Db2saasV1
+	createUser := func(id string, role string, locked string) {
+		authentication, err := db2saasService.NewCreateUserAuthentication("internal", "Default")
+		Expect(err).To(BeNil())
+		_, _, err = db2saasService.PostDb2SaasUser(db2saasService.NewPostDb2SaasUserOptions(fakeCRN, id, false, id+"-ibm-id", id,
+			"dEkMc43@gfAPl!867^dSbu", role, id+"@host.org", locked, authentication))
+		Expect(err).To(BeNil())
+	}
+	BeforeEach(func() {
+		var err error
+		fakeServer = db2saasfake.NewServer()
+		fakeServer.Passwords = passwords
+		db2saasService, err = fakeServer.NewService()
+		Expect(err).To(BeNil())
+
+		createUser("bluadmin", "bluadmin", "no")
+		createUser("ops-admin", "bluadmin", "no")
+		createUser("alice", "bluuser", "no")
+		createUser("bob", "bluuser", "no")
+		createUser("carol", "bluuser", "no")
+		createUser("dave", "bluuser", "yes")
+	})
+	AfterEach(func() {
+		fakeServer.Close()
+	})
+
+	desired := []db2saasv1.DesiredUser{
+		{ID: "alice", Role: db2saasv1.UserRole_Bluadmin, Email: "alice@example.org"},
+		{ID: "bob", Locked: core.BoolPtr(true)},
+		{ID: "dave", Locked: core.BoolPtr(false), Email: "dave@host.org"},
+		{ID: "erin", Email: "erin@host.org", Password: "dEkMc43@gfAPl!867^dSbu"},
+	}
+	if passwords != db2saasfake.PasswordsEchoed {
+		// The users to update need a password when the service does not return theirs.
+		for i := range desired[:3] {
+			desired[i].Password = "Bw3@mcD9!kLq72^xPz"
+		}
+	}
+	actions := func(result *db2saasv1.UserSyncResult) []string {
+		var actions []string
+		for _, action := range result.Actions {
+			actions = append(actions, action.Action+" "+action.ID+" "+action.Status)
+		}
+		return actions
+	}
+
+	It(`Invoke SyncUsers successfully: Dry run`, func() {
+		ref, err := db2saasv1.ParseDeploymentRef(fakeCRN)
+		Expect(err).To(BeNil())
+		result, err := db2saasService.SyncUsers(db2saasService.NewSyncUsersOptionsForDeployment(ref, desired).SetDryRun(true))
+		Expect(err).To(BeNil())
+		Expect(result.DryRun).To(BeTrue())
+		Expect(actions(result)).To(Equal([]string{
+			"update alice planned",
+			"lock bob planned",
+			"unlock dave planned",
+			"create erin planned",
+			"delete carol planned",
+		}))
+		Expect(result.Actions[0].Fields).To(Equal([]string{"role", "email"}))
+		Expect(result.Protected).To(Equal([]string{"bluadmin", "ops-admin"}))
+
+		var report bytes.Buffer
+		Expect(result.WriteReport(&report)).To(BeNil())
+		Expect(report.String()).To(ContainSubstring("User sync (dry run): 5 actions, 2 protected"))
+		Expect(report.String()).To(MatchRegexp(`update\s+alice\s+planned\s+role, email`))
+		Expect(report.String()).To(ContainSubstring("Kept protected user 'ops-admin'"))
+
+		Expect(fakeServer.Calls(db2saasfake.RoutePostUser)).To(Equal(6))
+		Expect(fakeServer.Calls(db2saasfake.RoutePutUser)).To(BeZero())
+		Expect(fakeServer.Calls(db2saasfake.RouteDeleteUser)).To(BeZero())
+	})
+	It(`Invoke SyncUsers successfully`, func() {
+		result, err := db2saasService.SyncUsers(db2saasService.NewSyncUsersOptions(fakeCRN, desired))
+		Expect(err).To(BeNil())
+		Expect(actions(result)).To(Equal([]string{
+			"update alice applied",
+			"lock bob applied",
+			"unlock dave applied",
+			"create erin applied",
+			"delete carol applied",
+		}))
+		Expect(fakeServer.UserIDs(fakeCRN)).To(Equal([]string{"alice", "bluadmin", "bob", "dave", "erin", "ops-admin"}))
+
+		alice, _, err := db2saasService.GetbyidDb2SaasUser(db2saasService.NewGetbyidDb2SaasUserOptions(fakeCRN, "alice"))
+		Expect(err).To(BeNil())
+		Expect(alice.UserRole()).To(Equal(db2saasv1.UserRole_Bluadmin))
+		Expect(*alice.Email).To(Equal("alice@example.org"))
+		Expect(*alice.Ibmid).To(Equal("alice-ibm-id"))
+		erin, _, err := db2saasService.GetbyidDb2SaasUser(db2saasService.NewGetbyidDb2SaasUserOptions(fakeCRN, "erin"))
+		Expect(err).To(BeNil())
+		Expect(erin.UserRole()).To(Equal(db2saasv1.UserRole_Bluuser))
+		Expect(*erin.Name).To(Equal("erin"))
+		Expect(erin.IsLocked()).To(BeFalse())
+		if passwords != db2saasfake.PasswordsEchoed {
+			// The updates sent the desired passwords.
+			fakeServer.Passwords = db2saasfake.PasswordsEchoed
+			db2saasService.SetRedactPasswords(false)
+			alice, _, err = db2saasService.GetbyidDb2SaasUser(db2saasService.NewGetbyidDb2SaasUserOptions(fakeCRN, "alice"))
+			Expect(err).To(BeNil())
+			Expect(*alice.Password).To(Equal("Bw3@mcD9!kLq72^xPz"))
+		}
+
+		// A second sync has nothing to do.
+		result, err = db2saasService.SyncUsers(db2saasService.NewSyncUsersOptions(fakeCRN, desired))
+		Expect(err).To(BeNil())
+		Expect(result.HasChanges()).To(BeFalse())
+	})
+	It(`Invoke SyncUsers successfully: Protected users`, func() {
+		instance, err := db2saasService.Instance(fakeCRN)
+		Expect(err).To(BeNil())
+		syncUsersOptionsModel := db2saasService.NewSyncUsersOptions("", []db2saasv1.DesiredUser{}).SetProtectedUsers([]string{"carol"})
+		result, err := instance.Users().Sync(syncUsersOptionsModel)
+		Expect(err).To(BeNil())
+		Expect(actions(result)).To(Equal([]string{"delete alice applied", "delete bob applied", "delete dave applied"}))
+		Expect(result.Protected).To(Equal([]string{"bluadmin", "carol", "ops-admin"}))
+		Expect(fakeServer.UserIDs(fakeCRN)).To(Equal([]string{"bluadmin", "carol", "ops-admin"}))
+	})
+	It(`Invoke SyncUsers with error: Failed action`, func() {
+		fakeServer.InjectError(db2saasfake.RoutePutUser, db2saasfake.Fault{StatusCode: http.StatusForbidden, Message: "forbidden", Times: 1})
+		result, err := db2saasService.SyncUsers(db2saasService.NewSyncUsersOptions(fakeCRN, desired))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("update user 'alice'"))
+		Expect(actions(result)).To(Equal([]string{
+			"update alice failed",
+			"lock bob skipped",
+			"unlock dave skipped",
+			"create erin skipped",
+			"delete carol skipped",
+		}))
+		Expect(result.Actions[0].Error).ToNot(BeNil())
+		Expect(fakeServer.UserIDs(fakeCRN)).To(ContainElement("carol"))
+	})
+	It(`Invoke SyncUsers with error: Missing password`, func() {
+		before := fakeServer.UserIDs(fakeCRN)
+		result, err := db2saasService.SyncUsers(db2saasService.NewSyncUsersOptions(fakeCRN, []db2saasv1.DesiredUser{
+			{ID: "alice"}, {ID: "frank"},
+		}))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("users[1]: a password is required to create user 'frank'"))
+		Expect(err.Error()).ToNot(ContainSubstring("alice"))
+		Expect(result).To(BeNil())
+		Expect(fakeServer.UserIDs(fakeCRN)).To(Equal(before))
+		Expect(fakeServer.Calls(db2saasfake.RouteDeleteUser)).To(BeZero())
+	})
+	if passwords != db2saasfake.PasswordsEchoed {
+		It(`Invoke SyncUsers with error: Password not returned`, func() {
+			withoutPasswords := append([]db2saasv1.DesiredUser(nil), desired...)
+			for i := range withoutPasswords[:3] {
+				withoutPasswords[i].Password = ""
+			}
+			before := fakeServer.UserIDs(fakeCRN)
+			result, err := db2saasService.SyncUsers(db2saasService.NewSyncUsersOptions(fakeCRN, withoutPasswords))
+			Expect(err).ToNot(BeNil())
+			Expect(result).To(BeNil())
+			var passwordErr *db2saasv1.UserPasswordUnavailableError
+			Expect(errors.As(err, &passwordErr)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("update user 'alice'"))
+			Expect(err.Error()).To(ContainSubstring("lock user 'bob'"))
+			Expect(err.Error()).To(ContainSubstring("unlock user 'dave'"))
+			Expect(fakeServer.UserIDs(fakeCRN)).To(Equal(before))
+			Expect(fakeServer.Calls(db2saasfake.RoutePostUser)).To(Equal(6))
+			Expect(fakeServer.Calls(db2saasfake.RoutePutUser)).To(BeZero())
+			Expect(fakeServer.Calls(db2saasfake.RouteDeleteUser)).To(BeZero())
+		})
+	}
+	It(`Invoke SyncUsers with error: Operation validation`, func() {
+		_, err := db2saasService.SyncUsers(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = db2saasService.SyncUsers(db2saasService.NewSyncUsersOptions(fakeCRN, nil))
+		Expect(err).ToNot(BeNil())
+		_, err = db2saasService.SyncUsers(db2saasService.NewSyncUsersOptions(fakeCRN, []db2saasv1.DesiredUser{
+			{ID: "alice"}, {ID: "alice"}, {ID: "bob", Role: "Bluadmin"},
+		}))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("users[1]: user 'alice' is also users[0]"))
+		Expect(err.Error()).To(ContainSubstring("users[2]: invalid role 'Bluadmin'"))
+		Expect(fakeServer.Calls(db2saasfake.RouteGetUsers)).To(BeZero())
+	})
+	Describe(`ReadDesiredUsers(r io.Reader)`, func() {
+		It(`Invoke ReadDesiredUsers successfully`, func() {
+			users, err := db2saasv1.ReadDesiredUsers(strings.NewReader(
+				"users:\n  - id: alice\n    role: bluadmin\n    locked: false\n  - id: bob\n    iam: true\n    ibmid: bob-ibm-id\n"))
+			Expect(err).To(BeNil())
+			Expect(users).To(Equal([]db2saasv1.DesiredUser{
+				{ID: "alice", Role: db2saasv1.UserRole_Bluadmin, Locked: core.BoolPtr(false)},
+				{ID: "bob", Iam: core.BoolPtr(true), Ibmid: "bob-ibm-id"},
+			}))
+
+			users, err = db2saasv1.ReadDesiredUsers(strings.NewReader(`[{"id": "alice", "email": "alice@host.org"}]`))
+			Expect(err).To(BeNil())
+			Expect(users).To(Equal([]db2saasv1.DesiredUser{{ID: "alice", Email: "alice@host.org"}}))
+
+			users, err = db2saasv1.ReadDesiredUsers(strings.NewReader(""))
+			Expect(err).To(BeNil())
+			Expect(users).ToNot(BeNil())
+			Expect(users).To(BeEmpty())
+		})
+		It(`Invoke ReadDesiredUsers with error`, func() {
+			for _, input := range []string{
+				"- id: alice\n  rol: bluadmin\n",
+				"users:\n  - id: alice\n  - id: alice\n",
+				"- email: nobody@host.org\n",
+				"- id: alice\n  locked: maybe\n",
+				"users: [",
+			} {
+				_, err := db2saasv1.ReadDesiredUsers(strings.NewReader(input))
+				Expect(err).ToNot(BeNil(), input)
+			}
+		})
+	})
+})
@@ -0,0 +1,241 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1
+
+import (
+	"fmt"
+	"strings"
+
+	common "github.com/IBM/cloud-db2-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// UserRole : The role of a database user, in the form sent in the "role" property of user requests.
+type UserRole string
+
+// Constants associated with UserRole.
+const (
+	UserRole_Bluadmin UserRole = "bluadmin"
+	UserRole_Bluuser  UserRole = "bluuser"
+)
+
+// ParseUserRole parses a role ignoring case and surrounding space, so "BluAdmin" yields UserRole_Bluadmin.
+func ParseUserRole(role string) (UserRole, error) {
+	for _, value := range []UserRole{UserRole_Bluadmin, UserRole_Bluuser} {
+		if strings.EqualFold(strings.TrimSpace(role), string(value)) {
+			return value, nil
+		}
+	}
+	return "", core.SDKErrorf(enumValueError("role", role, string(UserRole_Bluadmin), string(UserRole_Bluuser)),
+		"", "invalid-user-role", common.GetComponentInfo())
+}
+
+// Validate returns an error unless the role is one of the UserRole constants. The service compares
+// roles case-sensitively, so "Bluadmin" is not valid.
+func (role UserRole) Validate() error {
+	return validateEnumValue("role", string(role), string(UserRole_Bluadmin), string(UserRole_Bluuser))
+}
+
+// LockState : Whether a database user is locked, in the form sent in the "locked" property of user requests.
+type LockState string
+
+// Constants associated with LockState.
+const (
+	LockState_Locked   LockState = "yes"
+	LockState_Unlocked LockState = "no"
+)
+
+// LockStateFromBool returns LockState_Locked if "locked" is true and LockState_Unlocked otherwise.
+func LockStateFromBool(locked bool) LockState {
+	if locked {
+		return LockState_Locked
+	}
+	return LockState_Unlocked
+}
+
+// ParseLockState parses a lock state ignoring case and surrounding space. Besides "yes" and "no", it
+// accepts "true" and "false".
+func ParseLockState(state string) (LockState, error) {
+	switch strings.ToLower(strings.TrimSpace(state)) {
+	case "yes", "true":
+		return LockState_Locked, nil
+	case "no", "false":
+		return LockState_Unlocked, nil
+	}
+	return "", core.SDKErrorf(enumValueError("locked", state, string(LockState_Locked), string(LockState_Unlocked)),
+		"", "invalid-lock-state", common.GetComponentInfo())
+}
+
+// Locked returns true if the state is LockState_Locked.
+func (state LockState) Locked() bool {
+	return state == LockState_Locked
+}
+
+// Validate returns an error unless the state is one of the LockState constants. The service compares
+// lock states case-sensitively, so "YES" is not valid.
+func (state LockState) Validate() error {
+	return validateEnumValue("locked", string(state), string(LockState_Locked), string(LockState_Unlocked))
+}
+
+// validateEnumValue returns an error unless "value" is one of "allowed".
+func validateEnumValue(property string, value string, allowed ...string) error {
+	if containsString(allowed, value) {
+		return nil
+	}
+	return enumValueError(property, value, allowed...)
+}
+
+// enumValueError describes an invalid value of an enumerated property, suggesting the valid spelling
+// when the value differs from one only in case.
+func enumValueError(property string, value string, allowed ...string) error {
+	for _, candidate := range allowed {
+		if strings.EqualFold(strings.TrimSpace(value), candidate) {
+			return fmt.Errorf("invalid %s '%s': values are case-sensitive, use '%s'", property, value, candidate)
+		}
+	}
+	return fmt.Errorf("invalid %s '%s': must be one of '%s'", property, value, strings.Join(allowed, "', '"))
+}
+
+// validateEnumPtr is validateEnumValue for an optional property.
+func validateEnumPtr(property string, value *string, allowed ...string) error {
+	if value == nil {
+		return nil
+	}
+	return validateEnumValue(property, *value, allowed...)
+}
+
+// validateEnums checks the enumerated properties of the options.
+func (options *PostDb2SaasUserOptions) validateEnums() error {
+	if err := validateEnumPtr("role", options.Role, string(UserRole_Bluadmin), string(UserRole_Bluuser)); err != nil {
+		return err
+	}
+	return validateEnumPtr("locked", options.Locked, string(LockState_Locked), string(LockState_Unlocked))
+}
+
+// SetUserRole : Allow user to set Role from a UserRole
+func (_options *PostDb2SaasUserOptions) SetUserRole(role UserRole) *PostDb2SaasUserOptions {
+	_options.Role = core.StringPtr(string(role))
+	return _options
+}
+
+// SetLockState : Allow user to set Locked from a LockState
+func (_options *PostDb2SaasUserOptions) SetLockState(state LockState) *PostDb2SaasUserOptions {
+	_options.Locked = core.StringPtr(string(state))
+	return _options
+}
+
+// SetLockedBool : Allow user to set Locked from a bool, sent as "yes" or "no"
+func (_options *PostDb2SaasUserOptions) SetLockedBool(locked bool) *PostDb2SaasUserOptions {
+	return _options.SetLockState(LockStateFromBool(locked))
+}
+
+// validateEnums checks the enumerated properties of the options.
+func (options *PutDb2SaasUserOptions) validateEnums() error {
+	if err := validateEnumPtr("role", options.NewRole, string(UserRole_Bluadmin), string(UserRole_Bluuser)); err != nil {
+		return err
+	}
+	return validateEnumPtr("locked", options.NewLocked, string(LockState_Locked), string(LockState_Unlocked))
+}
+
+// SetNewUserRole : Allow user to set NewRole from a UserRole
+func (_options *PutDb2SaasUserOptions) SetNewUserRole(newRole UserRole) *PutDb2SaasUserOptions {
+	_options.NewRole = core.StringPtr(string(newRole))
+	return _options
+}
+
+// SetNewLockState : Allow user to set NewLocked from a LockState
+func (_options *PutDb2SaasUserOptions) SetNewLockState(newState LockState) *PutDb2SaasUserOptions {
+	_options.NewLocked = core.StringPtr(string(newState))
+	return _options
+}
+
+// SetNewLockedBool : Allow user to set NewLocked from a bool, sent as "yes" or "no"
+func (_options *PutDb2SaasUserOptions) SetNewLockedBool(newLocked bool) *PutDb2SaasUserOptions {
+	return _options.SetNewLockState(LockStateFromBool(newLocked))
+}
+
+// validateEnums checks the enumerated properties of the options.
+func (options *PutDb2SaasAutoscaleOptions) validateEnums() error {
+	err := validateEnumPtr("auto_scaling_enabled", options.AutoScalingEnabled,
+		PutDb2SaasAutoscaleOptions_AutoScalingEnabled_True, PutDb2SaasAutoscaleOptions_AutoScalingEnabled_False)
+	if err != nil {
+		return err
+	}
+	return validateEnumPtr("auto_scaling_allow_plan_limit", options.AutoScalingAllowPlanLimit,
+		PutDb2SaasAutoscaleOptions_AutoScalingAllowPlanLimit_Yes, PutDb2SaasAutoscaleOptions_AutoScalingAllowPlanLimit_No)
+}
+
+// SetAutoScalingEnabledBool : Allow user to set AutoScalingEnabled from a bool, sent as "true" or "false"
+func (_options *PutDb2SaasAutoscaleOptions) SetAutoScalingEnabledBool(autoScalingEnabled bool) *PutDb2SaasAutoscaleOptions {
+	if autoScalingEnabled {
+		return _options.SetAutoScalingEnabled(PutDb2SaasAutoscaleOptions_AutoScalingEnabled_True)
+	}
+	return _options.SetAutoScalingEnabled(PutDb2SaasAutoscaleOptions_AutoScalingEnabled_False)
+}
+
+// SetAutoScalingAllowPlanLimitBool : Allow user to set AutoScalingAllowPlanLimit from a bool, sent as "YES" or "NO"
+func (_options *PutDb2SaasAutoscaleOptions) SetAutoScalingAllowPlanLimitBool(autoScalingAllowPlanLimit bool) *PutDb2SaasAutoscaleOptions {
+	if autoScalingAllowPlanLimit {
+		return _options.SetAutoScalingAllowPlanLimit(PutDb2SaasAutoscaleOptions_AutoScalingAllowPlanLimit_Yes)
+	}
+	return _options.SetAutoScalingAllowPlanLimit(PutDb2SaasAutoscaleOptions_AutoScalingAllowPlanLimit_No)
+}
+
+// UserRole returns the role of the user.
+func (user *SuccessUserResponse) UserRole() UserRole {
+	return UserRole(core.StringNilMapper(user.Role))
+}
+
+// LockState returns whether the user is locked.
+func (user *SuccessUserResponse) LockState() LockState {
+	return LockState(core.StringNilMapper(user.Locked))
+}
+
+// IsLocked returns true if the user is locked.
+func (user *SuccessUserResponse) IsLocked() bool {
+	return user.LockState().Locked()
+}
+
+// UserRole returns the role of the user.
+func (user *SuccessGetUserByID) UserRole() UserRole {
+	return UserRole(core.StringNilMapper(user.Role))
+}
+
+// LockState returns whether the user is locked.
+func (user *SuccessGetUserByID) LockState() LockState {
+	return LockState(core.StringNilMapper(user.Locked))
+}
+
+// IsLocked returns true if the user is locked.
+func (user *SuccessGetUserByID) IsLocked() bool {
+	return user.LockState().Locked()
+}
+
+// UserRole returns the role of the user.
+func (user *SuccessGetUserInfoResourcesItem) UserRole() UserRole {
+	return UserRole(core.StringNilMapper(user.Role))
+}
+
+// LockState returns whether the user is locked.
+func (user *SuccessGetUserInfoResourcesItem) LockState() LockState {
+	return LockState(core.StringNilMapper(user.Locked))
+}
+
+// IsLocked returns true if the user is locked.
+func (user *SuccessGetUserInfoResourcesItem) IsLocked() bool {
+	return user.LockState().Locked()
+}
@@ -0,0 +1,181 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1_test
+
+import (
+	"github.com/IBM/cloud-db2-go-sdk/db2saasfake"
+	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`User types`, func() {
+	Describe(`UserRole and LockState`, func() {
+		It(`Invoke ParseUserRole successfully`, func() {
+			for input, expected := range map[string]db2saasv1.UserRole{
+				"bluadmin":   db2saasv1.UserRole_Bluadmin,
+				" BluAdmin ": db2saasv1.UserRole_Bluadmin,
+				"BLUUSER":    db2saasv1.UserRole_Bluuser,
+			} {
+				role, err := db2saasv1.ParseUserRole(input)
+				Expect(err).To(BeNil(), input)
+				Expect(role).To(Equal(expected), input)
+				Expect(role.Validate()).To(BeNil(), input)
+			}
+			_, err := db2saasv1.ParseUserRole("admin")
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("must be one of 'bluadmin', 'bluuser'"))
+		})
+		It(`Invoke ParseLockState successfully`, func() {
+			for input, expected := range map[string]db2saasv1.LockState{
+				"yes":   db2saasv1.LockState_Locked,
+				"YES":   db2saasv1.LockState_Locked,
+				"True":  db2saasv1.LockState_Locked,
+				" no":   db2saasv1.LockState_Unlocked,
+				"false": db2saasv1.LockState_Unlocked,
+			} {
+				state, err := db2saasv1.ParseLockState(input)
+				Expect(err).To(BeNil(), input)
+				Expect(state).To(Equal(expected), input)
+			}
+			_, err := db2saasv1.ParseLockState("locked")
+			Expect(err).ToNot(BeNil())
+
+			Expect(db2saasv1.LockStateFromBool(true)).To(Equal(db2saasv1.LockState_Locked))
+			Expect(db2saasv1.LockStateFromBool(false)).To(Equal(db2saasv1.LockState_Unlocked))
+			Expect(db2saasv1.LockState_Locked.Locked()).To(BeTrue())
+			Expect(db2saasv1.LockState_Unlocked.Locked()).To(BeFalse())
+		})
+		It(`Invoke Validate with error`, func() {
+			err := db2saasv1.UserRole("Bluadmin").Validate()
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("values are case-sensitive, use 'bluadmin'"))
+			Expect(db2saasv1.UserRole("").Validate()).ToNot(BeNil())
+			Expect(db2saasv1.LockState("YES").Validate()).ToNot(BeNil())
+			Expect(db2saasv1.LockState("true").Validate()).ToNot(BeNil())
+		})
+	})
+	Describe(`Typed setters and accessors`, func() {
+		db2saasService, _ := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+			URL:           "http://db2saasv1modelgenerator.com",
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		It(`Invoke typed setters successfully`, func() {
+			postDb2SaasUserOptionsModel := db2saasService.NewPostDb2SaasUserOptions(fakeCRN, "test-user", false, "", "", "", "", "", "", nil)
+			postDb2SaasUserOptionsModel.SetUserRole(db2saasv1.UserRole_Bluadmin).SetLockedBool(true)
+			Expect(*postDb2SaasUserOptionsModel.Role).To(Equal("bluadmin"))
+			Expect(*postDb2SaasUserOptionsModel.Locked).To(Equal("yes"))
+			postDb2SaasUserOptionsModel.SetLockState(db2saasv1.LockState_Unlocked)
+			Expect(*postDb2SaasUserOptionsModel.Locked).To(Equal("no"))
+
+			putDb2SaasUserOptionsModel := db2saasService.NewPutDb2SaasUserOptions(fakeCRN, "test-user", "test-user", false, "", "", "", "", "", "", nil)
+			putDb2SaasUserOptionsModel.SetNewUserRole(db2saasv1.UserRole_Bluuser).SetNewLockedBool(false)
+			Expect(*putDb2SaasUserOptionsModel.NewRole).To(Equal("bluuser"))
+			Expect(*putDb2SaasUserOptionsModel.NewLocked).To(Equal("no"))
+			putDb2SaasUserOptionsModel.SetNewLockState(db2saasv1.LockState_Locked)
+			Expect(*putDb2SaasUserOptionsModel.NewLocked).To(Equal("yes"))
+
+			putDb2SaasAutoscaleOptionsModel := db2saasService.NewPutDb2SaasAutoscaleOptions(fakeProfile)
+			putDb2SaasAutoscaleOptionsModel.SetAutoScalingEnabledBool(true).SetAutoScalingAllowPlanLimitBool(true)
+			Expect(*putDb2SaasAutoscaleOptionsModel.AutoScalingEnabled).To(Equal("true"))
+			Expect(*putDb2SaasAutoscaleOptionsModel.AutoScalingAllowPlanLimit).To(Equal("YES"))
+			putDb2SaasAutoscaleOptionsModel.SetAutoScalingEnabledBool(false).SetAutoScalingAllowPlanLimitBool(false)
+			Expect(*putDb2SaasAutoscaleOptionsModel.AutoScalingEnabled).To(Equal("false"))
+			Expect(*putDb2SaasAutoscaleOptionsModel.AutoScalingAllowPlanLimit).To(Equal("NO"))
+		})
+		It(`Invoke typed accessors successfully`, func() {
+			successUserResponse := &db2saasv1.SuccessUserResponse{Role: core.StringPtr("bluadmin"), Locked: core.StringPtr("yes")}
+			Expect(successUserResponse.UserRole()).To(Equal(db2saasv1.UserRole_Bluadmin))
+			Expect(successUserResponse.LockState()).To(Equal(db2saasv1.LockState_Locked))
+			Expect(successUserResponse.IsLocked()).To(BeTrue())
+
+			successGetUserByID := &db2saasv1.SuccessGetUserByID{Role: core.StringPtr("bluuser"), Locked: core.StringPtr("no")}
+			Expect(successGetUserByID.UserRole()).To(Equal(db2saasv1.UserRole_Bluuser))
+			Expect(successGetUserByID.IsLocked()).To(BeFalse())
+
+			successGetUserInfoResourcesItem := &db2saasv1.SuccessGetUserInfoResourcesItem{}
+			Expect(successGetUserInfoResourcesItem.UserRole()).To(BeEmpty())
+			Expect(successGetUserInfoResourcesItem.IsLocked()).To(BeFalse())
+		})
+	})
+	Describe(`Client-side enum validation`, func() {
+		var fakeServer *db2saasfake.Server
+		var db2saasService *db2saasv1.Db2saasV1
+		BeforeEach(func() {
+			var err error
+			fakeServer = db2saasfake.NewServer()
+			db2saasService, err = fakeServer.NewService()
+			Expect(err).To(BeNil())
+		})
+		AfterEach(func() {
+			fakeServer.Close()
+		})
+
+		It(`Invoke PostDb2SaasUser and PutDb2SaasUser with error: Enum validation`, func() {
+			authentication, err := db2saasService.NewCreateUserAuthentication("internal", "Default")
+			Expect(err).To(BeNil())
+			postDb2SaasUserOptionsModel := db2saasService.NewPostDb2SaasUserOptions(fakeCRN, "test-user", false, "test-ibm-id", "Test User",
+				"dEkMc43@gfAPl!867^dSbu", "bluuser", "test@host.org", "YES", authentication)
+			result, response, err := db2saasService.PostDb2SaasUser(postDb2SaasUserOptionsModel)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid locked 'YES'"))
+			Expect(result).To(BeNil())
+			Expect(response).To(BeNil())
+
+			postDb2SaasUserOptionsModel.SetLockedBool(true).SetRole("Bluuser")
+			_, _, err = db2saasService.PostDb2SaasUser(postDb2SaasUserOptionsModel)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid role 'Bluuser'"))
+			Expect(fakeServer.Calls(db2saasfake.RoutePostUser)).To(BeZero())
+
+			postDb2SaasUserOptionsModel.SetUserRole(db2saasv1.UserRole_Bluuser)
+			successUserResponse, _, err := db2saasService.PostDb2SaasUser(postDb2SaasUserOptionsModel)
+			Expect(err).To(BeNil())
+			Expect(successUserResponse.IsLocked()).To(BeTrue())
+
+			updateAuthentication := &db2saasv1.UpdateUserAuthentication{Method: core.StringPtr("internal"), PolicyID: core.StringPtr("Default")}
+			putDb2SaasUserOptionsModel := db2saasService.NewPutDb2SaasUserOptions(fakeCRN, "test-user", "test-user", false,
+				"test-ibm-id", "Test User", "dEkMc43@gfAPl!867^dSbu", "bluuser", "test@host.org", "True", updateAuthentication)
+			_, _, err = db2saasService.PutDb2SaasUser(putDb2SaasUserOptionsModel)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid locked 'True'"))
+			Expect(fakeServer.Calls(db2saasfake.RoutePutUser)).To(BeZero())
+		})
+		It(`Invoke PutDb2SaasAutoscale with error: Enum validation`, func() {
+			putDb2SaasAutoscaleOptionsModel := db2saasService.NewPutDb2SaasAutoscaleOptions(fakeProfile).SetAutoScalingEnabled("True")
+			_, _, err := db2saasService.PutDb2SaasAutoscale(putDb2SaasAutoscaleOptionsModel)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("values are case-sensitive, use 'true'"))
+
+			putDb2SaasAutoscaleOptionsModel.SetAutoScalingEnabledBool(true).SetAutoScalingAllowPlanLimit("yes")
+			_, _, err = db2saasService.PutDb2SaasAutoscale(putDb2SaasAutoscaleOptionsModel)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("use 'YES'"))
+			Expect(fakeServer.Calls(db2saasfake.RoutePutAutoscale)).To(BeZero())
+
+			putDb2SaasAutoscaleOptionsModel.SetAutoScalingAllowPlanLimitBool(true)
+			_, _, err = db2saasService.PutDb2SaasAutoscale(putDb2SaasAutoscaleOptionsModel)
+			Expect(err).To(BeNil())
+
+			successAutoScaling, _, err := db2saasService.GetDb2SaasAutoscale(db2saasService.NewGetDb2SaasAutoscaleOptions(fakeProfile))
+			Expect(err).To(BeNil())
+			Expect(*successAutoScaling.AutoScalingEnabled).To(BeTrue())
+			Expect(*successAutoScaling.AutoScalingAllowPlanLimit).To(BeTrue())
+		})
+	})
+})
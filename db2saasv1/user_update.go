@@ -0,0 +1,383 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	common "github.com/IBM/cloud-db2-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// UpdateDb2SaasUserOptions : The UpdateDb2SaasUser options.
+//
+// Unlike PutDb2SaasUserOptions, every new value is optional: the fields left nil keep the value the
+// user currently has.
+type UpdateDb2SaasUserOptions struct {
+	// CRN deployment id.
+	XDeploymentID *string `json:"x-deployment-id" validate:"required"`
+
+	// id of the user.
+	ID *string `json:"-" validate:"required,ne="`
+
+	// The id of the User.
+	NewID *string `json:"id,omitempty"`
+
+	// Indicates if IAM is enabled.
+	NewIam *bool `json:"iam,omitempty"`
+
+	// IBM ID of the User.
+	NewIbmid *string `json:"ibmid,omitempty"`
+
+	// The name of the User.
+	NewName *string `json:"name,omitempty"`
+
+	// Password of the User.
+	NewPassword *string `json:"password,omitempty"`
+
+	// Role of the User.
+	NewRole *string `json:"role,omitempty"`
+
+	// Email of the User.
+	NewEmail *string `json:"email,omitempty"`
+
+	// Indicates if the account is locked.
+	NewLocked *string `json:"locked,omitempty"`
+
+	NewAuthentication *UpdateUserAuthentication `json:"authentication,omitempty"`
+
+	// The user as the caller last read it. When set, the update fails with a UserConflictError if the user
+	// the service returns differs from it.
+	Expected *SuccessGetUserByID `json:"-" validate:"-"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewUpdateDb2SaasUserOptions : Instantiate UpdateDb2SaasUserOptions
+func (*Db2saasV1) NewUpdateDb2SaasUserOptions(xDeploymentID string, id string) *UpdateDb2SaasUserOptions {
+	return &UpdateDb2SaasUserOptions{
+		XDeploymentID: core.StringPtr(xDeploymentID),
+		ID:            core.StringPtr(id),
+	}
+}
+
+// NewUpdateDb2SaasUserOptionsForDeployment : Instantiate UpdateDb2SaasUserOptions for the deployment "ref"
+func (db2saas *Db2saasV1) NewUpdateDb2SaasUserOptionsForDeployment(ref *DeploymentRef, id string) *UpdateDb2SaasUserOptions {
+	return db2saas.NewUpdateDb2SaasUserOptions(ref.CRN(), id)
+}
+
+// SetXDeploymentID : Allow user to set XDeploymentID
+func (_options *UpdateDb2SaasUserOptions) SetXDeploymentID(xDeploymentID string) *UpdateDb2SaasUserOptions {
+	_options.XDeploymentID = core.StringPtr(xDeploymentID)
+	return _options
+}
+
+// SetID : Allow user to set ID
+func (_options *UpdateDb2SaasUserOptions) SetID(id string) *UpdateDb2SaasUserOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetNewID : Allow user to set NewID
+func (_options *UpdateDb2SaasUserOptions) SetNewID(newID string) *UpdateDb2SaasUserOptions {
+	_options.NewID = core.StringPtr(newID)
+	return _options
+}
+
+// SetNewIam : Allow user to set NewIam
+func (_options *UpdateDb2SaasUserOptions) SetNewIam(newIam bool) *UpdateDb2SaasUserOptions {
+	_options.NewIam = core.BoolPtr(newIam)
+	return _options
+}
+
+// SetNewIbmid : Allow user to set NewIbmid
+func (_options *UpdateDb2SaasUserOptions) SetNewIbmid(newIbmid string) *UpdateDb2SaasUserOptions {
+	_options.NewIbmid = core.StringPtr(newIbmid)
+	return _options
+}
+
+// SetNewName : Allow user to set NewName
+func (_options *UpdateDb2SaasUserOptions) SetNewName(newName string) *UpdateDb2SaasUserOptions {
+	_options.NewName = core.StringPtr(newName)
+	return _options
+}
+
+// SetNewPassword : Allow user to set NewPassword
+func (_options *UpdateDb2SaasUserOptions) SetNewPassword(newPassword string) *UpdateDb2SaasUserOptions {
+	_options.NewPassword = core.StringPtr(newPassword)
+	return _options
+}
+
+// SetNewRole : Allow user to set NewRole
+func (_options *UpdateDb2SaasUserOptions) SetNewRole(newRole string) *UpdateDb2SaasUserOptions {
+	_options.NewRole = core.StringPtr(newRole)
+	return _options
+}
+
+// SetNewUserRole : Allow user to set NewRole from a UserRole
+func (_options *UpdateDb2SaasUserOptions) SetNewUserRole(newRole UserRole) *UpdateDb2SaasUserOptions {
+	_options.NewRole = core.StringPtr(string(newRole))
+	return _options
+}
+
+// SetNewEmail : Allow user to set NewEmail
+func (_options *UpdateDb2SaasUserOptions) SetNewEmail(newEmail string) *UpdateDb2SaasUserOptions {
+	_options.NewEmail = core.StringPtr(newEmail)
+	return _options
+}
+
+// SetNewLocked : Allow user to set NewLocked
+func (_options *UpdateDb2SaasUserOptions) SetNewLocked(newLocked string) *UpdateDb2SaasUserOptions {
+	_options.NewLocked = core.StringPtr(newLocked)
+	return _options
+}
+
+// SetNewLockState : Allow user to set NewLocked from a LockState
+func (_options *UpdateDb2SaasUserOptions) SetNewLockState(newState LockState) *UpdateDb2SaasUserOptions {
+	_options.NewLocked = core.StringPtr(string(newState))
+	return _options
+}
+
+// SetNewLockedBool : Allow user to set NewLocked from a bool, sent as "yes" or "no"
+func (_options *UpdateDb2SaasUserOptions) SetNewLockedBool(newLocked bool) *UpdateDb2SaasUserOptions {
+	return _options.SetNewLockState(LockStateFromBool(newLocked))
+}
+
+// SetNewAuthentication : Allow user to set NewAuthentication
+func (_options *UpdateDb2SaasUserOptions) SetNewAuthentication(newAuthentication *UpdateUserAuthentication) *UpdateDb2SaasUserOptions {
+	_options.NewAuthentication = newAuthentication
+	return _options
+}
+
+// SetExpected : Allow user to set Expected
+func (_options *UpdateDb2SaasUserOptions) SetExpected(expected *SuccessGetUserByID) *UpdateDb2SaasUserOptions {
+	_options.Expected = expected
+	return _options
+}
+
+// SetDeployment : Allow user to set XDeploymentID from a DeploymentRef
+func (_options *UpdateDb2SaasUserOptions) SetDeployment(ref *DeploymentRef) *UpdateDb2SaasUserOptions {
+	_options.XDeploymentID = core.StringPtr(ref.CRN())
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *UpdateDb2SaasUserOptions) SetHeaders(param map[string]string) *UpdateDb2SaasUserOptions {
+	options.Headers = param
+	return options
+}
+
+// UserConflictError is returned by UpdateDb2SaasUser when the user differs from UpdateDb2SaasUserOptions.Expected.
+type UserConflictError struct {
+	// The id of the user.
+	ID string
+
+	// The properties that changed, named as in the request body, e.g. "email".
+	Fields []string
+}
+
+func (e *UserConflictError) Error() string {
+	return fmt.Sprintf("user '%s' was modified concurrently (%s); read it again and retry", e.ID, strings.Join(e.Fields, ", "))
+}
+
+// UserPasswordUnavailableError is returned by UpdateDb2SaasUser when NewPassword is not set and the service
+// does not return the current password of the user, or returns it masked, so that it cannot be sent back.
+// SyncUsers returns it, before applying any action, for each user to update that has no desired password.
+type UserPasswordUnavailableError struct {
+	// The id of the user.
+	ID string
+}
+
+func (e *UserPasswordUnavailableError) Error() string {
+	return fmt.Sprintf("the service did not return the password of user '%s'; set NewPassword to update the user", e.ID)
+}
+
+// UpdateDb2SaasUser : Update some properties of a user
+// Only the properties set in the options are changed; the others keep their current value, so a user's
+// email can be changed without knowing their password. This relies on GetbyidDb2SaasUser returning the
+// current password: when it is missing or masked, NewPassword must be set.
+func (db2saas *Db2saasV1) UpdateDb2SaasUser(updateDb2SaasUserOptions *UpdateDb2SaasUserOptions) (result *SuccessUserResponse, response *core.DetailedResponse, err error) {
+	result, response, err = db2saas.UpdateDb2SaasUserWithContext(context.Background(), updateDb2SaasUserOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdateDb2SaasUserWithContext is an alternate form of the UpdateDb2SaasUser method which supports a Context parameter.
+// The user is retrieved with GetbyidDb2SaasUser and the merged result is sent with PutDb2SaasUser. If Expected
+// is set, the update fails with a UserConflictError, without writing, when the retrieved user differs from it.
+// The service has no conditional update, so the write itself is last-writer-wins: a change made between the
+// read and the write is overwritten.
+func (db2saas *Db2saasV1) UpdateDb2SaasUserWithContext(ctx context.Context, updateDb2SaasUserOptions *UpdateDb2SaasUserOptions) (result *SuccessUserResponse, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(updateDb2SaasUserOptions, "updateDb2SaasUserOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(updateDb2SaasUserOptions, "updateDb2SaasUserOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	userID := updateDb2SaasUserOptions.ID
+	if updateDb2SaasUserOptions.NewID != nil {
+		userID = updateDb2SaasUserOptions.NewID
+	}
+	err = db2saas.validatePassword(updateDb2SaasUserOptions.NewPassword, userID)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "password-policy-error", common.GetComponentInfo())
+		return
+	}
+
+	getOptions := &GetbyidDb2SaasUserOptions{
+		XDeploymentID: updateDb2SaasUserOptions.XDeploymentID,
+		ID:            updateDb2SaasUserOptions.ID,
+		Headers:       updateDb2SaasUserOptions.Headers,
+	}
+	// The password is sent back unchanged, so it is read even when the client redacts passwords.
+	readCtx := withPasswords(ctx)
+	read, response, err := db2saas.GetbyidDb2SaasUserWithContext(readCtx, getOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-user-error")
+		return
+	}
+	if updateDb2SaasUserOptions.NewPassword == nil && !isReturnedPassword(read.Password) {
+		err = &UserPasswordUnavailableError{ID: *updateDb2SaasUserOptions.ID}
+		err = core.SDKErrorf(err, "", "user-password-unavailable", common.GetComponentInfo())
+		return
+	}
+	if expected := updateDb2SaasUserOptions.Expected; expected != nil {
+		if fields := diffExpectedUser(expected, read); len(fields) > 0 {
+			err = &UserConflictError{ID: *updateDb2SaasUserOptions.ID, Fields: fields}
+			err = core.SDKErrorf(err, "", "user-conflict", common.GetComponentInfo())
+			return
+		}
+	}
+	merged := *putOptionsFromUser(read)
+	updateDb2SaasUserOptions.mergeInto(&merged)
+	merged.XDeploymentID = updateDb2SaasUserOptions.XDeploymentID
+	merged.ID = updateDb2SaasUserOptions.ID
+	merged.Headers = updateDb2SaasUserOptions.Headers
+	err = merged.validateEnums()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "enum-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	result, response, err = db2saas.PutDb2SaasUserWithContext(ctx, &merged)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "put-user-error")
+	}
+	return
+}
+
+// mergeInto overwrites the new values of "put" with the ones set in the options.
+func (options *UpdateDb2SaasUserOptions) mergeInto(put *PutDb2SaasUserOptions) {
+	if options.NewID != nil {
+		put.NewID = options.NewID
+	}
+	if options.NewIam != nil {
+		put.NewIam = options.NewIam
+	}
+	if options.NewIbmid != nil {
+		put.NewIbmid = options.NewIbmid
+	}
+	if options.NewName != nil {
+		put.NewName = options.NewName
+	}
+	if options.NewPassword != nil {
+		put.NewPassword = options.NewPassword
+	}
+	if options.NewRole != nil {
+		put.NewRole = options.NewRole
+	}
+	if options.NewEmail != nil {
+		put.NewEmail = options.NewEmail
+	}
+	if options.NewLocked != nil {
+		put.NewLocked = options.NewLocked
+	}
+	if options.NewAuthentication != nil {
+		put.NewAuthentication = options.NewAuthentication
+	}
+}
+
+// isReturnedPassword returns true if "password" is a password returned by the service, rather than
+// missing, empty, or masked by the service or by RedactPassword.
+func isReturnedPassword(password *string) bool {
+	if password == nil || *password == "" || *password == RedactedPassword {
+		return false
+	}
+	return strings.Trim(*password, "*") != ""
+}
+
+// putOptionsFromUser returns PutDb2SaasUser options whose new values are the current values of "user".
+func putOptionsFromUser(user *SuccessGetUserByID) *PutDb2SaasUserOptions {
+	options := &PutDb2SaasUserOptions{
+		NewID:       user.ID,
+		NewIam:      user.Iam,
+		NewIbmid:    user.Ibmid,
+		NewName:     user.Name,
+		NewPassword: user.Password,
+		NewRole:     user.Role,
+		NewEmail:    user.Email,
+		NewLocked:   user.Locked,
+	}
+	if user.Authentication != nil {
+		options.NewAuthentication = &UpdateUserAuthentication{
+			Method:   user.Authentication.Method,
+			PolicyID: user.Authentication.PolicyID,
+		}
+	}
+	return options
+}
+
+// diffExpectedUser returns the names of the properties of "actual" that differ from "expected". Passwords are
+// only compared when both were returned, since the caller's read is usually redacted.
+func diffExpectedUser(expected *SuccessGetUserByID, actual *SuccessGetUserByID) []string {
+	a, b := putOptionsFromUser(expected), putOptionsFromUser(actual)
+	if !isReturnedPassword(a.NewPassword) || !isReturnedPassword(b.NewPassword) {
+		a.NewPassword, b.NewPassword = nil, nil
+	}
+	return diffUserFields(a, b)
+}
+
+// diffUserFields returns the names of the new values that differ between "a" and "b".
+func diffUserFields(a *PutDb2SaasUserOptions, b *PutDb2SaasUserOptions) (fields []string) {
+	for _, field := range []struct {
+		name string
+		a, b interface{}
+	}{
+		{"id", a.NewID, b.NewID},
+		{"iam", a.NewIam, b.NewIam},
+		{"ibmid", a.NewIbmid, b.NewIbmid},
+		{"name", a.NewName, b.NewName},
+		{"password", a.NewPassword, b.NewPassword},
+		{"role", a.NewRole, b.NewRole},
+		{"email", a.NewEmail, b.NewEmail},
+		{"locked", a.NewLocked, b.NewLocked},
+		{"authentication", a.NewAuthentication, b.NewAuthentication},
+	} {
+		if !reflect.DeepEqual(field.a, field.b) {
+			fields = append(fields, field.name)
+		}
+	}
+	return
+}
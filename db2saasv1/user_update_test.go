@@ -0,0 +1,176 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/cloud-db2-go-sdk/db2saasfake"
+	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// describePasswordModes describes the specs of "body" once for each way the fake server returns passwords.
+func describePasswordModes(text string, body func(passwords db2saasfake.PasswordMode)) bool {
+	for _, passwords := range db2saasfake.PasswordModes {
+		passwords := passwords
+		Describe(fmt.Sprintf("%s (passwords %s)", text, passwords), func() {
+			body(passwords)
+		})
+	}
+	return true
+}
+
+var _ = describePasswordModes(`UpdateDb2SaasUser`, func(passwords db2saasfake.PasswordMode) {
+	var fakeServer *db2saasfake.Server
+	var db2saasService *db2saasv1.Db2saasV1
+	BeforeEach(func() {
+		var err error
+		fakeServer = db2saasfake.NewServer()
+		fakeServer.Passwords = passwords
+		db2saasService, err = fakeServer.NewService()
+		Expect(err).To(BeNil())
+
+		authentication, err := db2saasService.NewCreateUserAuthentication("internal", "Default")
+		Expect(err).To(BeNil())
+		_, _, err = db2saasService.PostDb2SaasUser(db2saasService.NewPostDb2SaasUserOptions(fakeCRN, "test-user", false, "test-ibm-id",
+			"Test User", "dEkMc43@gfAPl!867^dSbu", "bluuser", "test@host.org", "no", authentication))
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		fakeServer.Close()
+	})
+
+	getUser := func(id string) *db2saasv1.SuccessGetUserByID {
+		successGetUserByID, _, err := db2saasService.GetbyidDb2SaasUser(db2saasService.NewGetbyidDb2SaasUserOptions(fakeCRN, id))
+		Expect(err).To(BeNil())
+		return successGetUserByID
+	}
+	// sendPassword sets the current password when the service does not return it, as callers then have to.
+	sendPassword := func(options *db2saasv1.UpdateDb2SaasUserOptions) *db2saasv1.UpdateDb2SaasUserOptions {
+		if passwords != db2saasfake.PasswordsEchoed {
+			options.SetNewPassword("dEkMc43@gfAPl!867^dSbu")
+		}
+		return options
+	}
+
+	It(`Invoke UpdateDb2SaasUser successfully`, func() {
+		updateDb2SaasUserOptionsModel := sendPassword(db2saasService.NewUpdateDb2SaasUserOptions(fakeCRN, "test-user").
+			SetNewEmail("new@host.org").
+			SetNewLockedBool(true))
+		successUserResponse, response, err := db2saasService.UpdateDb2SaasUser(updateDb2SaasUserOptionsModel)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		Expect(*successUserResponse.Email).To(Equal("new@host.org"))
+		Expect(successUserResponse.Password).To(BeNil())
+
+		// The update keeps the password, although the client redacts it from the responses.
+		fakeServer.Passwords = db2saasfake.PasswordsEchoed
+		db2saasService.SetRedactPasswords(false)
+		user := getUser("test-user")
+		Expect(*user.Email).To(Equal("new@host.org"))
+		Expect(user.IsLocked()).To(BeTrue())
+		Expect(*user.Password).To(Equal("dEkMc43@gfAPl!867^dSbu"))
+		Expect(*user.Name).To(Equal("Test User"))
+		Expect(user.UserRole()).To(Equal(db2saasv1.UserRole_Bluuser))
+		Expect(*user.Authentication.PolicyID).To(Equal("Default"))
+		Expect(fakeServer.Calls(db2saasfake.RoutePutUser)).To(Equal(1))
+	})
+	It(`Invoke UpdateDb2SaasUser successfully: Rename the user`, func() {
+		ref, err := db2saasv1.ParseDeploymentRef(fakeCRN)
+		Expect(err).To(BeNil())
+		_, _, err = db2saasService.UpdateDb2SaasUser(sendPassword(db2saasService.NewUpdateDb2SaasUserOptionsForDeployment(ref, "test-user").SetNewID("renamed-user")))
+		Expect(err).To(BeNil())
+		Expect(fakeServer.UserIDs(fakeCRN)).To(Equal([]string{"renamed-user"}))
+		Expect(*getUser("renamed-user").Email).To(Equal("test@host.org"))
+	})
+	It(`Invoke Patch through an instance handle`, func() {
+		instance, err := db2saasService.Instance(fakeCRN)
+		Expect(err).To(BeNil())
+		updateDb2SaasUserOptionsModel := sendPassword(db2saasService.NewUpdateDb2SaasUserOptions("", "test-user").SetNewUserRole(db2saasv1.UserRole_Bluadmin))
+		_, _, err = instance.Users().Patch(updateDb2SaasUserOptionsModel)
+		Expect(err).To(BeNil())
+		Expect(*updateDb2SaasUserOptionsModel.XDeploymentID).To(BeEmpty())
+		Expect(getUser("test-user").UserRole()).To(Equal(db2saasv1.UserRole_Bluadmin))
+	})
+	It(`Invoke UpdateDb2SaasUser with error: Concurrent modification`, func() {
+		expected := getUser("test-user")
+		_, _, err := db2saasService.UpdateDb2SaasUser(sendPassword(db2saasService.NewUpdateDb2SaasUserOptions(fakeCRN, "test-user").SetNewName("Other Tool")))
+		Expect(err).To(BeNil())
+		Expect(fakeServer.Calls(db2saasfake.RoutePutUser)).To(Equal(1))
+
+		_, _, err = db2saasService.UpdateDb2SaasUser(sendPassword(db2saasService.NewUpdateDb2SaasUserOptions(fakeCRN, "test-user").
+			SetNewEmail("new@host.org").
+			SetExpected(expected)))
+		Expect(err).ToNot(BeNil())
+		var conflictErr *db2saasv1.UserConflictError
+		Expect(errors.As(err, &conflictErr)).To(BeTrue())
+		Expect(conflictErr.ID).To(Equal("test-user"))
+		Expect(conflictErr.Fields).To(Equal([]string{"name"}))
+		Expect(fakeServer.Calls(db2saasfake.RoutePutUser)).To(Equal(1))
+
+		user := getUser("test-user")
+		Expect(*user.Name).To(Equal("Other Tool"))
+		Expect(*user.Email).To(Equal("test@host.org"))
+
+		_, _, err = db2saasService.UpdateDb2SaasUser(sendPassword(db2saasService.NewUpdateDb2SaasUserOptions(fakeCRN, "test-user").
+			SetNewEmail("new@host.org").
+			SetExpected(user)))
+		Expect(err).To(BeNil())
+		Expect(*getUser("test-user").Email).To(Equal("new@host.org"))
+		Expect(fakeServer.Calls(db2saasfake.RouteGetUserByID)).To(Equal(6))
+	})
+	if passwords != db2saasfake.PasswordsEchoed {
+		It(`Invoke UpdateDb2SaasUser with error: Password not returned`, func() {
+			_, _, err := db2saasService.UpdateDb2SaasUser(db2saasService.NewUpdateDb2SaasUserOptions(fakeCRN, "test-user").SetNewEmail("new@host.org"))
+			Expect(err).ToNot(BeNil())
+			var passwordErr *db2saasv1.UserPasswordUnavailableError
+			Expect(errors.As(err, &passwordErr)).To(BeTrue())
+			Expect(passwordErr.ID).To(Equal("test-user"))
+			Expect(fakeServer.Calls(db2saasfake.RoutePutUser)).To(BeZero())
+
+			// A new password does not depend on the current one.
+			_, _, err = db2saasService.UpdateDb2SaasUser(db2saasService.NewUpdateDb2SaasUserOptions(fakeCRN, "test-user").
+				SetNewEmail("new@host.org").
+				SetNewPassword("hQ4s!pX9@vLr2#Kd8^mZ"))
+			Expect(err).To(BeNil())
+			fakeServer.Passwords = db2saasfake.PasswordsEchoed
+			db2saasService.SetRedactPasswords(false)
+			user := getUser("test-user")
+			Expect(*user.Email).To(Equal("new@host.org"))
+			Expect(*user.Password).To(Equal("hQ4s!pX9@vLr2#Kd8^mZ"))
+		})
+	}
+	It(`Invoke UpdateDb2SaasUser with error: Operation validation`, func() {
+		_, _, err := db2saasService.UpdateDb2SaasUser(nil)
+		Expect(err).ToNot(BeNil())
+		_, _, err = db2saasService.UpdateDb2SaasUser(db2saasService.NewUpdateDb2SaasUserOptions(fakeCRN, ""))
+		Expect(err).ToNot(BeNil())
+
+		_, response, err := db2saasService.UpdateDb2SaasUser(db2saasService.NewUpdateDb2SaasUserOptions(fakeCRN, "missing-user").SetNewEmail("new@host.org"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+
+		_, _, err = db2saasService.UpdateDb2SaasUser(sendPassword(db2saasService.NewUpdateDb2SaasUserOptions(fakeCRN, "test-user").SetNewLocked("YES")))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("use 'yes'"))
+		Expect(fakeServer.Calls(db2saasfake.RoutePutUser)).To(BeZero())
+	})
+})
@@ -0,0 +1,455 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	common "github.com/IBM/cloud-db2-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Default polling settings used by the WaitFor* helpers.
+const (
+	DefaultWaitInitialInterval  = 5 * time.Second
+	DefaultWaitMaxInterval      = time.Minute
+	DefaultWaitMultiplier       = 2.0
+	DefaultWaitTimeout          = time.Hour
+	DefaultWaitMaxNotFoundPolls = 5
+)
+
+// Restore statuses reported by GetDb2SaasRestore.
+const (
+	Restore_Status_Completed  = "completed"
+	Restore_Status_Failed     = "failed"
+	Restore_Status_InProgress = "in_progress"
+)
+
+// DefaultBackupTerminalStatuses are the backup statuses at which WaitForBackup stops polling.
+var DefaultBackupTerminalStatuses = []string{
+	Backup_Status_Completed,
+	Backup_Status_Failed,
+}
+
+// DefaultRestoreTerminalStatuses are the restore statuses at which WaitForRestore stops polling.
+var DefaultRestoreTerminalStatuses = []string{
+	Restore_Status_Completed,
+	Restore_Status_Failed,
+}
+
+// Backoff : Exponential backoff used between polls.
+type Backoff struct {
+	// The interval before the second poll. Defaults to DefaultWaitInitialInterval.
+	InitialInterval time.Duration
+
+	// The upper bound of the interval between polls. Defaults to DefaultWaitMaxInterval.
+	MaxInterval time.Duration
+
+	// The factor applied to the interval after each poll. Defaults to DefaultWaitMultiplier.
+	Multiplier float64
+}
+
+// intervals returns the effective initial interval, maximum interval and multiplier.
+func (backoff *Backoff) intervals() (initial time.Duration, max time.Duration, multiplier float64) {
+	initial, max, multiplier = DefaultWaitInitialInterval, DefaultWaitMaxInterval, DefaultWaitMultiplier
+	if backoff == nil {
+		return
+	}
+	if backoff.InitialInterval > 0 {
+		initial = backoff.InitialInterval
+	}
+	if backoff.MaxInterval > 0 {
+		max = backoff.MaxInterval
+	}
+	if backoff.Multiplier >= 1 {
+		multiplier = backoff.Multiplier
+	}
+	if initial > max {
+		initial = max
+	}
+	return
+}
+
+// poll invokes "check" until it reports done, it fails, or "timeout" elapses.
+// A zero timeout means DefaultWaitTimeout; the context deadline, if earlier, still applies.
+func poll(ctx context.Context, backoff *Backoff, timeout time.Duration, what string, check func(context.Context) (bool, error)) error {
+	if timeout <= 0 {
+		timeout = DefaultWaitTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	interval, maxInterval, multiplier := backoff.intervals()
+	for {
+		done, err := check(ctx)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return core.SDKErrorf(ctx.Err(), fmt.Sprintf("stopped waiting for %s: %s", what, ctx.Err().Error()), "wait-timeout", common.GetComponentInfo())
+		case <-timer.C:
+		}
+
+		interval = time.Duration(float64(interval) * multiplier)
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// WaitNotFoundError is returned by the WaitFor* helpers when the item waited for is missing from the list
+// returned by the service for too many consecutive polls.
+type WaitNotFoundError struct {
+	// What was waited for, e.g. "backup 'id'".
+	What string
+
+	// The number of consecutive polls that did not find it.
+	Polls int
+}
+
+func (e *WaitNotFoundError) Error() string {
+	return fmt.Sprintf("%s not found after %d polls", e.What, e.Polls)
+}
+
+// notFoundCounter counts the consecutive polls that did not find the item waited for.
+type notFoundCounter struct {
+	what  string
+	max   int
+	polls int
+}
+
+func newNotFoundCounter(what string, max int) *notFoundCounter {
+	if max <= 0 {
+		max = DefaultWaitMaxNotFoundPolls
+	}
+	return &notFoundCounter{what: what, max: max}
+}
+
+// missed records a poll that did not find the item and returns a WaitNotFoundError once the limit is reached.
+func (counter *notFoundCounter) missed() error {
+	counter.polls++
+	if counter.polls < counter.max {
+		return nil
+	}
+	err := &WaitNotFoundError{What: counter.what, Polls: counter.polls}
+	return core.SDKErrorf(err, "", "wait-not-found", common.GetComponentInfo())
+}
+
+// containsString returns true if "values" contains "value".
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// WaitForBackupOptions : The WaitForBackup options.
+type WaitForBackupOptions struct {
+	// Encoded CRN deployment id.
+	XDbProfile *string `json:"x-db-profile" validate:"required"`
+
+	// The id of the backup to wait for, as listed by GetDb2SaasBackup. The API reference does not relate it to
+	// the task id returned by PostDb2SaasBackup, which it describes as the CRN of the instance; when the two
+	// differ, the wait fails once MaxNotFoundPolls polls have not found the backup.
+	BackupID *string `json:"backup_id" validate:"required,ne="`
+
+	// The statuses at which polling stops. Defaults to DefaultBackupTerminalStatuses.
+	TerminalStatuses []string
+
+	// The backoff used between polls.
+	Backoff *Backoff
+
+	// The maximum time to wait. Defaults to DefaultWaitTimeout.
+	Timeout time.Duration
+
+	// The number of consecutive polls the backup may be missing from GetDb2SaasBackup before WaitForBackup
+	// fails with a WaitNotFoundError. Defaults to DefaultWaitMaxNotFoundPolls.
+	MaxNotFoundPolls int
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewWaitForBackupOptions : Instantiate WaitForBackupOptions
+func (*Db2saasV1) NewWaitForBackupOptions(xDbProfile string, backupID string) *WaitForBackupOptions {
+	return &WaitForBackupOptions{
+		XDbProfile: core.StringPtr(xDbProfile),
+		BackupID:   core.StringPtr(backupID),
+	}
+}
+
+// SetXDbProfile : Allow user to set XDbProfile
+func (_options *WaitForBackupOptions) SetXDbProfile(xDbProfile string) *WaitForBackupOptions {
+	_options.XDbProfile = core.StringPtr(xDbProfile)
+	return _options
+}
+
+// SetBackupID : Allow user to set BackupID
+func (_options *WaitForBackupOptions) SetBackupID(backupID string) *WaitForBackupOptions {
+	_options.BackupID = core.StringPtr(backupID)
+	return _options
+}
+
+// SetTerminalStatuses : Allow user to set TerminalStatuses
+func (_options *WaitForBackupOptions) SetTerminalStatuses(terminalStatuses []string) *WaitForBackupOptions {
+	_options.TerminalStatuses = terminalStatuses
+	return _options
+}
+
+// SetBackoff : Allow user to set Backoff
+func (_options *WaitForBackupOptions) SetBackoff(backoff *Backoff) *WaitForBackupOptions {
+	_options.Backoff = backoff
+	return _options
+}
+
+// SetTimeout : Allow user to set Timeout
+func (_options *WaitForBackupOptions) SetTimeout(timeout time.Duration) *WaitForBackupOptions {
+	_options.Timeout = timeout
+	return _options
+}
+
+// SetMaxNotFoundPolls : Allow user to set MaxNotFoundPolls
+func (_options *WaitForBackupOptions) SetMaxNotFoundPolls(maxNotFoundPolls int) *WaitForBackupOptions {
+	_options.MaxNotFoundPolls = maxNotFoundPolls
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *WaitForBackupOptions) SetHeaders(param map[string]string) *WaitForBackupOptions {
+	options.Headers = param
+	return options
+}
+
+// WaitForBackup : Wait for a backup to reach a terminal status
+func (db2saas *Db2saasV1) WaitForBackup(waitForBackupOptions *WaitForBackupOptions) (result *Backup, err error) {
+	result, err = db2saas.WaitForBackupWithContext(context.Background(), waitForBackupOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// WaitForBackupWithContext is an alternate form of the WaitForBackup method which supports a Context parameter.
+// It polls GetDb2SaasBackup until the backup identified by BackupID reports one of the terminal statuses and
+// returns that backup. Each poll reads the pages of backups until the backup is found. The caller should
+// inspect its Status to tell success from failure. If the backup is missing from MaxNotFoundPolls consecutive
+// polls, it fails with a WaitNotFoundError.
+func (db2saas *Db2saasV1) WaitForBackupWithContext(ctx context.Context, waitForBackupOptions *WaitForBackupOptions) (result *Backup, err error) {
+	err = core.ValidateNotNil(waitForBackupOptions, "waitForBackupOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(waitForBackupOptions, "waitForBackupOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	terminalStatuses := waitForBackupOptions.TerminalStatuses
+	if len(terminalStatuses) == 0 {
+		terminalStatuses = DefaultBackupTerminalStatuses
+	}
+	getDb2SaasBackupOptions := &GetDb2SaasBackupOptions{
+		XDbProfile: waitForBackupOptions.XDbProfile,
+		Headers:    waitForBackupOptions.Headers,
+	}
+
+	what := fmt.Sprintf("backup '%s'", *waitForBackupOptions.BackupID)
+	notFound := newNotFoundCounter(what, waitForBackupOptions.MaxNotFoundPolls)
+	err = poll(ctx, waitForBackupOptions.Backoff, waitForBackupOptions.Timeout, what, func(ctx context.Context) (bool, error) {
+		backup, err := db2saas.findBackup(ctx, getDb2SaasBackupOptions, *waitForBackupOptions.BackupID)
+		if err != nil {
+			return false, err
+		}
+		if backup == nil {
+			return false, notFound.missed()
+		}
+		notFound.polls = 0
+		if backup.Status != nil && containsString(terminalStatuses, *backup.Status) {
+			result = backup
+			return true, nil
+		}
+		return false, nil
+	})
+	return
+}
+
+// findBackup returns the backup whose id is "backupID", or nil if none is listed. The pages listed by
+// GetDb2SaasBackup are read until the backup is found.
+func (db2saas *Db2saasV1) findBackup(ctx context.Context, getDb2SaasBackupOptions *GetDb2SaasBackupOptions, backupID string) (*Backup, error) {
+	pager, err := db2saas.NewGetDb2SaasBackupPager(getDb2SaasBackupOptions)
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "pager-error")
+	}
+	for pager.HasNext() {
+		backups, err := pager.GetNextWithContext(ctx)
+		if err != nil {
+			return nil, core.RepurposeSDKProblem(err, "get-backup-error")
+		}
+		for i := range backups {
+			if backups[i].ID != nil && *backups[i].ID == backupID {
+				return &backups[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// WaitForRestoreOptions : The WaitForRestore options.
+type WaitForRestoreOptions struct {
+	// Encoded CRN deployment id.
+	XDbProfile *string `json:"x-db-profile" validate:"required"`
+
+	// The id of the restore to wait for, as listed by GetDb2SaasRestore. The API reference does not relate it
+	// to the task id returned by PostDb2SaasRestore; when the two differ, the wait fails once MaxNotFoundPolls
+	// polls have not found the restore.
+	RestoreID *string `json:"restore_id" validate:"required,ne="`
+
+	// The statuses at which polling stops. Defaults to DefaultRestoreTerminalStatuses.
+	TerminalStatuses []string
+
+	// The backoff used between polls.
+	Backoff *Backoff
+
+	// The maximum time to wait. Defaults to DefaultWaitTimeout.
+	Timeout time.Duration
+
+	// The number of consecutive polls the restore may be missing from GetDb2SaasRestore before WaitForRestore
+	// fails with a WaitNotFoundError. Defaults to DefaultWaitMaxNotFoundPolls.
+	MaxNotFoundPolls int
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewWaitForRestoreOptions : Instantiate WaitForRestoreOptions
+func (*Db2saasV1) NewWaitForRestoreOptions(xDbProfile string, restoreID string) *WaitForRestoreOptions {
+	return &WaitForRestoreOptions{
+		XDbProfile: core.StringPtr(xDbProfile),
+		RestoreID:  core.StringPtr(restoreID),
+	}
+}
+
+// SetXDbProfile : Allow user to set XDbProfile
+func (_options *WaitForRestoreOptions) SetXDbProfile(xDbProfile string) *WaitForRestoreOptions {
+	_options.XDbProfile = core.StringPtr(xDbProfile)
+	return _options
+}
+
+// SetRestoreID : Allow user to set RestoreID
+func (_options *WaitForRestoreOptions) SetRestoreID(restoreID string) *WaitForRestoreOptions {
+	_options.RestoreID = core.StringPtr(restoreID)
+	return _options
+}
+
+// SetTerminalStatuses : Allow user to set TerminalStatuses
+func (_options *WaitForRestoreOptions) SetTerminalStatuses(terminalStatuses []string) *WaitForRestoreOptions {
+	_options.TerminalStatuses = terminalStatuses
+	return _options
+}
+
+// SetBackoff : Allow user to set Backoff
+func (_options *WaitForRestoreOptions) SetBackoff(backoff *Backoff) *WaitForRestoreOptions {
+	_options.Backoff = backoff
+	return _options
+}
+
+// SetTimeout : Allow user to set Timeout
+func (_options *WaitForRestoreOptions) SetTimeout(timeout time.Duration) *WaitForRestoreOptions {
+	_options.Timeout = timeout
+	return _options
+}
+
+// SetMaxNotFoundPolls : Allow user to set MaxNotFoundPolls
+func (_options *WaitForRestoreOptions) SetMaxNotFoundPolls(maxNotFoundPolls int) *WaitForRestoreOptions {
+	_options.MaxNotFoundPolls = maxNotFoundPolls
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *WaitForRestoreOptions) SetHeaders(param map[string]string) *WaitForRestoreOptions {
+	options.Headers = param
+	return options
+}
+
+// WaitForRestore : Wait for a restore to reach a terminal status
+func (db2saas *Db2saasV1) WaitForRestore(waitForRestoreOptions *WaitForRestoreOptions) (result *Restore, err error) {
+	result, err = db2saas.WaitForRestoreWithContext(context.Background(), waitForRestoreOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// WaitForRestoreWithContext is an alternate form of the WaitForRestore method which supports a Context parameter.
+// It polls GetDb2SaasRestore until the restore identified by RestoreID reports one of the terminal statuses and
+// returns that restore. The caller should inspect its Status to tell success from failure. If the restore is
+// missing from MaxNotFoundPolls consecutive polls, it fails with a WaitNotFoundError.
+func (db2saas *Db2saasV1) WaitForRestoreWithContext(ctx context.Context, waitForRestoreOptions *WaitForRestoreOptions) (result *Restore, err error) {
+	err = core.ValidateNotNil(waitForRestoreOptions, "waitForRestoreOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(waitForRestoreOptions, "waitForRestoreOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	terminalStatuses := waitForRestoreOptions.TerminalStatuses
+	if len(terminalStatuses) == 0 {
+		terminalStatuses = DefaultRestoreTerminalStatuses
+	}
+	getDb2SaasRestoreOptions := &GetDb2SaasRestoreOptions{
+		XDbProfile: waitForRestoreOptions.XDbProfile,
+		Headers:    waitForRestoreOptions.Headers,
+	}
+
+	what := fmt.Sprintf("restore '%s'", *waitForRestoreOptions.RestoreID)
+	notFound := newNotFoundCounter(what, waitForRestoreOptions.MaxNotFoundPolls)
+	err = poll(ctx, waitForRestoreOptions.Backoff, waitForRestoreOptions.Timeout, what, func(ctx context.Context) (bool, error) {
+		restores, _, err := db2saas.GetDb2SaasRestoreWithContext(ctx, getDb2SaasRestoreOptions)
+		if err != nil {
+			return false, core.RepurposeSDKProblem(err, "get-restore-error")
+		}
+		if restores == nil {
+			return false, notFound.missed()
+		}
+		for i := range restores.Restores {
+			restore := &restores.Restores[i]
+			if restore.ID != nil && *restore.ID == *waitForRestoreOptions.RestoreID {
+				notFound.polls = 0
+				if restore.Status != nil && containsString(terminalStatuses, *restore.Status) {
+					result = restore
+					return true, nil
+				}
+				return false, nil
+			}
+		}
+		return false, notFound.missed()
+	})
+	return
+}
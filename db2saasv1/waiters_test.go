@@ -0,0 +1,275 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db2saasv1_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	"github.com/IBM/cloud-db2-go-sdk/db2saasfake"
+	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const fakeCRN = "crn:v1:staging:public:dashdb-for-transactions:us-south:a/e7e3e87b512f474381c0684a5ecbba03:39269573-e43f-43e8-8b93-09f44c2ff875::"
+
+var fakeProfile = url.QueryEscape(fakeCRN)
+
+var fastBackoff = &db2saasv1.Backoff{
+	InitialInterval: time.Millisecond,
+	MaxInterval:     5 * time.Millisecond,
+}
+
+// newEmptyResponseService returns a service whose requests all succeed with an empty body, and its server.
+func newEmptyResponseService() (*db2saasv1.Db2saasV1, *httptest.Server) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(http.StatusOK)
+	}))
+	db2saasService, err := db2saasv1.NewDb2saasV1(&db2saasv1.Db2saasV1Options{
+		URL:           testServer.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	Expect(err).To(BeNil())
+	return db2saasService, testServer
+}
+
+var _ = Describe(`Waiters`, func() {
+	var fakeServer *db2saasfake.Server
+	var db2saasService *db2saasv1.Db2saasV1
+
+	BeforeEach(func() {
+		var err error
+		fakeServer = db2saasfake.NewServer()
+		db2saasService, err = fakeServer.NewService()
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		fakeServer.Close()
+	})
+
+	Describe(`WaitForBackup(waitForBackupOptions *WaitForBackupOptions)`, func() {
+		createBackup := func() string {
+			created, _, err := db2saasService.PostDb2SaasBackup(db2saasService.NewPostDb2SaasBackupOptions(fakeProfile))
+			Expect(err).To(BeNil())
+			return *created.Task.ID
+		}
+
+		It(`Invoke WaitForBackup until the backup completes`, func() {
+			fakeServer.BackupPolls = 2
+			backupID := createBackup()
+
+			waitForBackupOptionsModel := db2saasService.NewWaitForBackupOptions(fakeProfile, backupID)
+			waitForBackupOptionsModel.SetBackoff(fastBackoff)
+			backup, err := db2saasService.WaitForBackup(waitForBackupOptionsModel)
+			Expect(err).To(BeNil())
+			Expect(*backup.ID).To(Equal(backupID))
+			Expect(*backup.Status).To(Equal(db2saasv1.Backup_Status_Completed))
+			Expect(fakeServer.Calls(db2saasfake.RouteGetBackups)).To(Equal(3))
+		})
+		It(`Invoke WaitForBackup and return a failed backup`, func() {
+			fakeServer.BackupPolls = 100
+			backupID := createBackup()
+			Expect(fakeServer.SetBackupStatus(fakeCRN, backupID, db2saasv1.Backup_Status_Failed)).To(BeTrue())
+
+			waitForBackupOptionsModel := db2saasService.NewWaitForBackupOptions(fakeProfile, backupID)
+			waitForBackupOptionsModel.SetBackoff(fastBackoff)
+			backup, err := db2saasService.WaitForBackupWithContext(context.Background(), waitForBackupOptionsModel)
+			Expect(err).To(BeNil())
+			Expect(*backup.Status).To(Equal(db2saasv1.Backup_Status_Failed))
+		})
+		It(`Invoke WaitForBackup with custom terminal statuses`, func() {
+			fakeServer.BackupPolls = 100
+			backupID := createBackup()
+
+			waitForBackupOptionsModel := db2saasService.NewWaitForBackupOptions(fakeProfile, backupID)
+			waitForBackupOptionsModel.SetTerminalStatuses([]string{db2saasv1.Backup_Status_InProgress})
+			backup, err := db2saasService.WaitForBackup(waitForBackupOptionsModel)
+			Expect(err).To(BeNil())
+			Expect(*backup.Status).To(Equal(db2saasv1.Backup_Status_InProgress))
+		})
+		It(`Invoke WaitForBackup and time out`, func() {
+			fakeServer.BackupPolls = 1000
+			backupID := createBackup()
+
+			waitForBackupOptionsModel := db2saasService.NewWaitForBackupOptions(fakeProfile, backupID)
+			waitForBackupOptionsModel.SetBackoff(fastBackoff)
+			waitForBackupOptionsModel.SetTimeout(50 * time.Millisecond)
+			backup, err := db2saasService.WaitForBackup(waitForBackupOptionsModel)
+			Expect(err).ToNot(BeNil())
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+			Expect(backup).To(BeNil())
+		})
+		It(`Invoke WaitForBackup for a backup that never appears`, func() {
+			waitForBackupOptionsModel := db2saasService.NewWaitForBackupOptions(fakeProfile, "unknown-task")
+			waitForBackupOptionsModel.SetBackoff(fastBackoff)
+			backup, err := db2saasService.WaitForBackup(waitForBackupOptionsModel)
+			Expect(err).ToNot(BeNil())
+			Expect(backup).To(BeNil())
+			var notFoundErr *db2saasv1.WaitNotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(notFoundErr.Polls).To(Equal(db2saasv1.DefaultWaitMaxNotFoundPolls))
+			Expect(err.Error()).To(ContainSubstring("backup 'unknown-task' not found after 5 polls"))
+			Expect(fakeServer.Calls(db2saasfake.RouteGetBackups)).To(Equal(db2saasv1.DefaultWaitMaxNotFoundPolls))
+
+			waitForBackupOptionsModel.SetMaxNotFoundPolls(2)
+			_, err = db2saasService.WaitForBackup(waitForBackupOptionsModel)
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(fakeServer.Calls(db2saasfake.RouteGetBackups)).To(Equal(db2saasv1.DefaultWaitMaxNotFoundPolls + 2))
+		})
+		It(`Invoke WaitForBackup for a backup listed on a later page`, func() {
+			fakeServer.BackupPageSize = 2
+			createBackup()
+			createBackup()
+			backupID := createBackup()
+
+			waitForBackupOptionsModel := db2saasService.NewWaitForBackupOptions(fakeProfile, backupID)
+			waitForBackupOptionsModel.SetBackoff(fastBackoff)
+			backup, err := db2saasService.WaitForBackup(waitForBackupOptionsModel)
+			Expect(err).To(BeNil())
+			Expect(*backup.ID).To(Equal(backupID))
+			Expect(fakeServer.Calls(db2saasfake.RouteGetBackups)).To(Equal(2))
+		})
+		It(`Invoke WaitForBackup with an empty response`, func() {
+			emptyService, testServer := newEmptyResponseService()
+			defer testServer.Close()
+			waitForBackupOptionsModel := emptyService.NewWaitForBackupOptions(fakeProfile, "unknown-task")
+			waitForBackupOptionsModel.SetBackoff(fastBackoff)
+			waitForBackupOptionsModel.SetMaxNotFoundPolls(2)
+			backup, err := emptyService.WaitForBackup(waitForBackupOptionsModel)
+			Expect(backup).To(BeNil())
+			var notFoundErr *db2saasv1.WaitNotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+		})
+		It(`Invoke WaitForBackup and stop on a service error`, func() {
+			backupID := createBackup()
+			fakeServer.InjectError(db2saasfake.RouteGetBackups, db2saasfake.Fault{StatusCode: http.StatusForbidden})
+
+			waitForBackupOptionsModel := db2saasService.NewWaitForBackupOptions(fakeProfile, backupID)
+			waitForBackupOptionsModel.SetBackoff(fastBackoff)
+			backup, err := db2saasService.WaitForBackup(waitForBackupOptionsModel)
+			Expect(err).ToNot(BeNil())
+			Expect(backup).To(BeNil())
+			Expect(fakeServer.Calls(db2saasfake.RouteGetBackups)).To(Equal(1))
+		})
+		It(`Invoke WaitForBackup with error: Operation validation`, func() {
+			backup, err := db2saasService.WaitForBackup(nil)
+			Expect(err).ToNot(BeNil())
+			Expect(backup).To(BeNil())
+
+			backup, err = db2saasService.WaitForBackup(&db2saasv1.WaitForBackupOptions{XDbProfile: &fakeProfile})
+			Expect(err).ToNot(BeNil())
+			Expect(backup).To(BeNil())
+		})
+	})
+	Describe(`WaitForRestore(waitForRestoreOptions *WaitForRestoreOptions)`, func() {
+		createRestore := func() string {
+			backup, _, err := db2saasService.PostDb2SaasBackup(db2saasService.NewPostDb2SaasBackupOptions(fakeProfile))
+			Expect(err).To(BeNil())
+			postDb2SaasRestoreOptionsModel := db2saasService.NewPostDb2SaasRestoreOptions(fakeProfile)
+			postDb2SaasRestoreOptionsModel.SetBackupID(*backup.Task.ID)
+			created, _, err := db2saasService.PostDb2SaasRestore(postDb2SaasRestoreOptionsModel)
+			Expect(err).To(BeNil())
+			return *created.Task.ID
+		}
+
+		It(`Invoke WaitForRestore until the restore completes`, func() {
+			fakeServer.RestorePolls = 2
+			restoreID := createRestore()
+
+			waitForRestoreOptionsModel := db2saasService.NewWaitForRestoreOptions(fakeProfile, restoreID)
+			waitForRestoreOptionsModel.SetBackoff(fastBackoff)
+			restore, err := db2saasService.WaitForRestore(waitForRestoreOptionsModel)
+			Expect(err).To(BeNil())
+			Expect(*restore.ID).To(Equal(restoreID))
+			Expect(*restore.Status).To(Equal(db2saasv1.Restore_Status_Completed))
+			Expect(fakeServer.Calls(db2saasfake.RouteGetRestores)).To(Equal(3))
+		})
+		It(`Invoke WaitForRestore and return a failed restore`, func() {
+			fakeServer.RestorePolls = 100
+			restoreID := createRestore()
+			Expect(fakeServer.SetRestoreStatus(fakeCRN, restoreID, db2saasv1.Restore_Status_Failed)).To(BeTrue())
+
+			waitForRestoreOptionsModel := db2saasService.NewWaitForRestoreOptions(fakeProfile, restoreID)
+			waitForRestoreOptionsModel.SetBackoff(fastBackoff)
+			restore, err := db2saasService.WaitForRestoreWithContext(context.Background(), waitForRestoreOptionsModel)
+			Expect(err).To(BeNil())
+			Expect(*restore.Status).To(Equal(db2saasv1.Restore_Status_Failed))
+		})
+		It(`Invoke WaitForRestore and time out`, func() {
+			fakeServer.RestorePolls = 1000
+			restoreID := createRestore()
+
+			waitForRestoreOptionsModel := db2saasService.NewWaitForRestoreOptions(fakeProfile, restoreID)
+			waitForRestoreOptionsModel.SetBackoff(fastBackoff)
+			waitForRestoreOptionsModel.SetTimeout(50 * time.Millisecond)
+			restore, err := db2saasService.WaitForRestore(waitForRestoreOptionsModel)
+			Expect(err).ToNot(BeNil())
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+			Expect(restore).To(BeNil())
+		})
+		It(`Invoke WaitForRestore with an empty response`, func() {
+			emptyService, testServer := newEmptyResponseService()
+			defer testServer.Close()
+			waitForRestoreOptionsModel := emptyService.NewWaitForRestoreOptions(fakeProfile, "unknown-task")
+			waitForRestoreOptionsModel.SetBackoff(fastBackoff)
+			waitForRestoreOptionsModel.SetMaxNotFoundPolls(2)
+			restore, err := emptyService.WaitForRestore(waitForRestoreOptionsModel)
+			Expect(restore).To(BeNil())
+			var notFoundErr *db2saasv1.WaitNotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+		})
+		It(`Invoke WaitForRestore for a restore that never appears`, func() {
+			waitForRestoreOptionsModel := db2saasService.NewWaitForRestoreOptions(fakeProfile, "unknown-task")
+			waitForRestoreOptionsModel.SetBackoff(fastBackoff)
+			waitForRestoreOptionsModel.SetMaxNotFoundPolls(3)
+			restore, err := db2saasService.WaitForRestore(waitForRestoreOptionsModel)
+			Expect(err).ToNot(BeNil())
+			Expect(restore).To(BeNil())
+			var notFoundErr *db2saasv1.WaitNotFoundError
+			Expect(errors.As(err, &notFoundErr)).To(BeTrue())
+			Expect(notFoundErr.What).To(Equal("restore 'unknown-task'"))
+			Expect(fakeServer.Calls(db2saasfake.RouteGetRestores)).To(Equal(3))
+		})
+		It(`Invoke PostDb2SaasRestore with error: Invalid target`, func() {
+			_, _, err := db2saasService.PostDb2SaasRestore(db2saasService.NewPostDb2SaasRestoreOptions(fakeProfile))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("a restore needs a backup_id or a target_time"))
+
+			_, _, err = db2saasService.PostDb2SaasRestore(db2saasService.NewPostDb2SaasRestoreOptions(fakeProfile).
+				SetBackupID("backup-1").
+				SetTargetTime("2025-01-01T00:00:00Z"))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("a restore takes a backup_id or a target_time, not both"))
+			Expect(fakeServer.Calls(db2saasfake.RoutePostRestore)).To(BeZero())
+		})
+		It(`Invoke WaitForRestore with error: Operation validation`, func() {
+			restore, err := db2saasService.WaitForRestore(nil)
+			Expect(err).ToNot(BeNil())
+			Expect(restore).To(BeNil())
+
+			restore, err = db2saasService.WaitForRestore(&db2saasv1.WaitForRestoreOptions{XDbProfile: &fakeProfile})
+			Expect(err).ToNot(BeNil())
+			Expect(restore).To(BeNil())
+		})
+	})
+})